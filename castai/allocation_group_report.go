@@ -0,0 +1,154 @@
+package castai
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+)
+
+const (
+	FieldAllocationGroupReportGroupID          = "allocation_group_id"
+	FieldAllocationGroupReportClusterIDs       = "cluster_ids"
+	FieldAllocationGroupReportStartTime        = "start_time"
+	FieldAllocationGroupReportEndTime          = "end_time"
+	FieldAllocationGroupReportWindow           = "window"
+	FieldAllocationGroupReportUseListingPrices = "use_listing_prices"
+	FieldAllocationGroupReportGroupName        = "group_name"
+)
+
+// allocationGroupReportSchema returns the attributes selecting the allocation group and time window of allocation
+// group reports, merged with the report specific attributes.
+func allocationGroupReportSchema(reportSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	out := map[string]*schema.Schema{
+		FieldAllocationGroupReportGroupID: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsUUID),
+			Description:      "ID of the allocation group.",
+		},
+		FieldAllocationGroupReportClusterIDs: {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Report only on the given CAST AI clusters of the allocation group.",
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsUUID),
+			},
+		},
+		FieldAllocationGroupReportStartTime: {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ConflictsWith:    []string{FieldAllocationGroupReportWindow},
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+			Description:      "Start of the reported period in RFC3339 format. Defaults to `window` before `end_time`.",
+		},
+		FieldAllocationGroupReportEndTime: {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+			Description:      "End of the reported period in RFC3339 format. Defaults to the time of reading the data source.",
+		},
+		FieldAllocationGroupReportWindow: {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ConflictsWith:    []string{FieldAllocationGroupReportStartTime},
+			ValidateDiagFunc: validateDuration,
+			Description:      "Length of the reported period ending at `end_time`, e.g. `24h`. Defaults to `720h` (30 days).",
+		},
+		FieldAllocationGroupReportGroupName: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Name of the allocation group.",
+		},
+	}
+	for key, s := range reportSchema {
+		out[key] = s
+	}
+
+	return out
+}
+
+// allocationGroupReportPeriod returns the reported period configured by the time window attributes.
+func allocationGroupReportPeriod(d *schema.ResourceData, now time.Time) (start, end time.Time, err error) {
+	end = now.UTC()
+	if v, ok := d.GetOk(FieldAllocationGroupReportEndTime); ok {
+		if end, err = time.Parse(time.RFC3339, v.(string)); err != nil {
+			return start, end, fmt.Errorf("parsing end_time: %w", err)
+		}
+	}
+
+	window := 30 * 24 * time.Hour
+	if v, ok := d.GetOk(FieldAllocationGroupReportWindow); ok {
+		if window, err = time.ParseDuration(v.(string)); err != nil {
+			return start, end, fmt.Errorf("parsing window: %w", err)
+		}
+	}
+	start = end.Add(-window)
+	if v, ok := d.GetOk(FieldAllocationGroupReportStartTime); ok {
+		if start, err = time.Parse(time.RFC3339, v.(string)); err != nil {
+			return start, end, fmt.Errorf("parsing start_time: %w", err)
+		}
+	}
+
+	if !start.Before(end) {
+		return start, end, fmt.Errorf("start of the reported period %s must be before its end %s", start.Format(time.RFC3339), end.Format(time.RFC3339))
+	}
+	return start, end, nil
+}
+
+func allocationGroupReportClusterIDs(d *schema.ResourceData) *[]string {
+	if v, ok := d.Get(FieldAllocationGroupReportClusterIDs).([]any); ok && len(v) > 0 {
+		return lo.ToPtr(toStringList(v))
+	}
+	return nil
+}
+
+// getAllocationGroupName returns the name of an allocation group missing from a report, which happens when it had
+// nothing to report in the period. It fails when the allocation group doesn't exist.
+func getAllocationGroupName(ctx context.Context, client sdk.ClientWithResponsesInterface, groupID string) (string, error) {
+	resp, err := client.AllocationGroupAPIGetAllocationGroupWithResponse(ctx, groupID)
+	if checkErr := sdk.CheckOKResponse(resp, err); checkErr != nil {
+		if sdk.IsNotFound(checkErr) {
+			return "", fmt.Errorf("allocation group %s not found", groupID)
+		}
+		return "", fmt.Errorf("getting allocation group: %w", checkErr)
+	}
+
+	return lo.FromPtr(resp.JSON200.Name), nil
+}
+
+// parseAllocationGroupAmounts parses decimal amounts keyed by attribute, which the cost report API returns as strings.
+// Missing amounts are zero.
+func parseAllocationGroupAmounts(amounts map[string]*string) (map[string]float64, error) {
+	out := make(map[string]float64, len(amounts))
+	for field, amount := range amounts {
+		if lo.FromPtr(amount) == "" {
+			out[field] = 0
+			continue
+		}
+		parsed, err := strconv.ParseFloat(*amount, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", field, err)
+		}
+		out[field] = parsed
+	}
+
+	return out, nil
+}
+
+// allocationGroupUseListingPricesSchema returns the attribute selecting prices of reports which support listing prices.
+func allocationGroupUseListingPricesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Use listing prices instead of actual prices.",
+	}
+}
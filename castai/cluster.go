@@ -2,15 +2,11 @@ package castai
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log"
 	"net/http"
-	"time"
 
-	"github.com/cenkalti/backoff/v4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/castai/terraform-provider-castai/castai/sdk"
@@ -30,35 +26,36 @@ func resourceCastaiClusterDelete(ctx context.Context, data *schema.ResourceData,
 
 	log.Printf("[INFO] Checking current status of the cluster.")
 
-	err := retry.RetryContext(ctx, data.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+	err := waitFor(ctx, fmt.Sprintf("cluster %s deletion", clusterId), data.Timeout(schema.TimeoutDelete), func(ctx context.Context) (bool, string, error) {
 		clusterResponse, err := client.ExternalClusterAPIGetClusterWithResponse(ctx, clusterId)
 		if checkErr := sdk.CheckOKResponse(clusterResponse, err); checkErr != nil {
-			return retry.NonRetryableError(err)
+			return false, "", checkErr
 		}
 
 		clusterStatus := *clusterResponse.JSON200.Status
 		agentStatus := *clusterResponse.JSON200.AgentStatus
+		status := fmt.Sprintf("cluster status %s agent status %s", clusterStatus, agentStatus)
 		log.Printf("[INFO] Current cluster status=%s, agent_status=%s", clusterStatus, agentStatus)
 
 		if clusterStatus == sdk.ClusterStatusArchived {
 			log.Printf("[INFO] Cluster is already deleted, removing from state.")
 			data.SetId("")
-			return nil
+			return true, status, nil
 		}
 
-		triggerDisconnect := func() *retry.RetryError {
+		triggerDisconnect := func() (bool, string, error) {
 			response, err := client.ExternalClusterAPIDisconnectClusterWithResponse(ctx, clusterId, sdk.ExternalClusterAPIDisconnectClusterJSONRequestBody{
 				DeleteProvisionedNodes:  getOptionalBool(data, FieldDeleteNodesOnDisconnect, false),
 				KeepKubernetesResources: toPtr(true),
 			})
 			if checkErr := sdk.CheckOKResponse(response, err); checkErr != nil {
-				return retry.NonRetryableError(err)
+				return false, "", checkErr
 			}
 
-			return retry.RetryableError(fmt.Errorf("triggered agent disconnection cluster status %s agent status %s", clusterStatus, agentStatus))
+			return false, "triggered agent disconnection " + status, nil
 		}
 
-		triggerDelete := func() *retry.RetryError {
+		triggerDelete := func() (bool, string, error) {
 			log.Printf("[INFO] Deleting cluster.")
 			res, err := client.ExternalClusterAPIDeleteClusterWithResponse(ctx, clusterId)
 			if res.StatusCode() == 400 {
@@ -66,9 +63,9 @@ func resourceCastaiClusterDelete(ctx context.Context, data *schema.ResourceData,
 			}
 
 			if checkErr := sdk.CheckResponseNoContent(res, err); checkErr != nil {
-				return retry.NonRetryableError(fmt.Errorf("error when deleting cluster status %s agent status %s error: %w", clusterStatus, agentStatus, err))
+				return false, "", fmt.Errorf("error when deleting cluster %s error: %w", status, checkErr)
 			}
-			return retry.RetryableError(fmt.Errorf("triggered cluster deletion"))
+			return false, "triggered cluster deletion", nil
 		}
 
 		if agentStatus == sdk.ClusterAgentStatusDisconnected || clusterStatus == sdk.ClusterStatusDeleted {
@@ -86,11 +83,11 @@ func resourceCastaiClusterDelete(ctx context.Context, data *schema.ResourceData,
 		}
 
 		if agentStatus == sdk.ClusterAgentStatusDisconnecting {
-			return retry.RetryableError(fmt.Errorf("agent is disconnecting cluster status %s agent status %s", clusterStatus, agentStatus))
+			return false, "agent is disconnecting " + status, nil
 		}
 
 		if clusterStatus == sdk.ClusterStatusDeleting {
-			return retry.RetryableError(fmt.Errorf("cluster is deleting cluster status %s agent status %s", clusterStatus, agentStatus))
+			return false, "cluster is deleting " + status, nil
 		}
 
 		if toString(clusterResponse.JSON200.CredentialsId) != "" && agentStatus != sdk.ClusterAgentStatusDisconnected {
@@ -102,7 +99,7 @@ func resourceCastaiClusterDelete(ctx context.Context, data *schema.ResourceData,
 			return triggerDelete()
 		}
 
-		return retry.RetryableError(fmt.Errorf("retrying cluster status %s agent status %s", clusterStatus, agentStatus))
+		return false, status, nil
 	})
 
 	if err != nil {
@@ -144,14 +141,18 @@ func resourceCastaiClusterUpdate(
 	data *schema.ResourceData,
 	request *sdk.ExternalClusterAPIUpdateClusterJSONRequestBody,
 ) error {
-	b := backoff.WithContext(backoff.NewExponentialBackOff(), ctx)
+	// Updates are retried until the resource's timeout, mostly waiting for IAM changes to propagate.
+	timeout := data.Timeout(schema.TimeoutUpdate)
+	if data.IsNewResource() {
+		timeout = data.Timeout(schema.TimeoutCreate)
+	}
 
-	var lastErr error
 	var credentialsID string
-	if err := backoff.RetryNotify(func() error {
+	if err := waitFor(ctx, fmt.Sprintf("cluster %s update", data.Id()), timeout, func(ctx context.Context) (bool, string, error) {
 		response, err := client.ExternalClusterAPIUpdateClusterWithResponse(ctx, data.Id(), *request)
 		if err != nil {
-			return fmt.Errorf("error when calling update cluster API: %w", err)
+			log.Printf("[WARN] Encountered error while updating cluster settings, will retry: %v", err)
+			return false, fmt.Sprintf("error when calling update cluster API: %v", err), nil
 		}
 
 		err = sdk.StatusOk(response)
@@ -160,25 +161,20 @@ func resourceCastaiClusterUpdate(
 			// In case of malformed user request return error to user right away.
 			// Credentials error is omitted as permissions propagate eventually and sometimes aren't visible immediately.
 			if response.StatusCode() == 400 && !sdk.IsCredentialsError(response) {
-				return backoff.Permanent(err)
+				return false, "", err
 			}
 
 			if response.StatusCode() == 400 && sdk.IsCredentialsError(response) {
 				log.Printf("[WARN] Received credentials error from backend, will retry in case the issue is caused by IAM eventual consistency.")
 			}
-			return fmt.Errorf("error in update cluster response: %w", err)
+			log.Printf("[WARN] Encountered error while updating cluster settings, will retry: %v", err)
+			return false, fmt.Sprintf("error in update cluster response: %v", err), nil
 		}
 
 		if response.JSON200.CredentialsId != nil {
 			credentialsID = *response.JSON200.CredentialsId
 		}
-		return nil
-	}, b, func(err error, _ time.Duration) {
-		// Only store non-context errors so we can surface the last "real" error to the user at the end
-		if !errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, context.Canceled) {
-			lastErr = err
-		}
-		log.Printf("[WARN] Encountered error while updating cluster settings, will retry: %v", err)
+		return true, "updated", nil
 	}); err != nil {
 		// Reset CredentialsID in state in case of failed updates.
 		// This is because TF will save the raw credentials in state even on failed updates.
@@ -190,9 +186,6 @@ func resourceCastaiClusterUpdate(
 			log.Printf("[ERROR] Failed to reset cluster credentials ID after failed update: %v", err)
 		}
 
-		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
-			return fmt.Errorf("updating cluster configuration failed due to context: %w; last observed error was: %v", err, lastErr)
-		}
 		return fmt.Errorf("updating cluster configuration: %w", err)
 	}
 
@@ -206,6 +199,33 @@ func resourceCastaiClusterUpdate(
 	return nil
 }
 
+// clusterStateImporter imports a cluster by its ID. importFields sets provider-specific fields which are not
+// populated by the read. Credentials cannot be read back from the API, so they stay unset and are applied in place
+// from configuration on the next apply. credentials_id is imported to keep the read from reporting credentials drift.
+func clusterStateImporter(importFields func(cluster *sdk.ExternalclusterV1Cluster, data *schema.ResourceData) error) schema.StateContextFunc {
+	return func(ctx context.Context, data *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		client := meta.(*ProviderConfig).api
+		clusterID := data.Id()
+
+		resp, err := fetchClusterData(ctx, client, clusterID)
+		if err != nil {
+			return nil, fmt.Errorf("fetching cluster %s: %w", clusterID, err)
+		}
+		if resp == nil || resp.JSON200 == nil {
+			return nil, fmt.Errorf("cluster %s not found", clusterID)
+		}
+
+		if err := importFields(resp.JSON200, data); err != nil {
+			return nil, err
+		}
+		if err := data.Set(FieldClusterCredentialsId, toString(resp.JSON200.CredentialsId)); err != nil {
+			return nil, fmt.Errorf("setting credentials id: %w", err)
+		}
+
+		return []*schema.ResourceData{data}, nil
+	}
+}
+
 func createClusterToken(ctx context.Context, client sdk.ClientWithResponsesInterface, clusterID string) (string, error) {
 	resp, err := client.ExternalClusterAPICreateClusterTokenWithResponse(ctx, clusterID)
 	if checkErr := sdk.CheckOKResponse(resp, err); checkErr != nil {
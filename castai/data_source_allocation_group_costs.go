@@ -0,0 +1,234 @@
+package castai
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/samber/lo"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+)
+
+const (
+	FieldAllocationGroupCostsStep                  = "step"
+	FieldAllocationGroupCostsIncludeIdleResources  = "include_idle_resource_costs"
+	FieldAllocationGroupCostsTotalCost             = "total_cost"
+	FieldAllocationGroupCostsTotalCostOnDemand     = "total_cost_on_demand"
+	FieldAllocationGroupCostsTotalCostSpot         = "total_cost_spot"
+	FieldAllocationGroupCostsTotalCostSpotFallback = "total_cost_spot_fallback"
+	FieldAllocationGroupCostsCpuCost               = "cpu_cost"
+	FieldAllocationGroupCostsRamCost               = "ram_cost"
+	FieldAllocationGroupCostsGpuCost               = "gpu_cost"
+	FieldAllocationGroupCostsRequestedCpuHours     = "requested_cpu_hours"
+	FieldAllocationGroupCostsRequestedRamGibHours  = "requested_ram_gib_hours"
+	FieldAllocationGroupCostsTimeseries            = "timeseries"
+	FieldAllocationGroupCostsTimestamp             = "timestamp"
+
+	// allocationGroupCostsMaxSteps limits the number of timeseries entries, which are kept in state.
+	allocationGroupCostsMaxSteps = 1000
+)
+
+func dataSourceAllocationGroupCosts() *schema.Resource {
+	costSchema := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: description,
+		}
+	}
+
+	return &schema.Resource{
+		Description: "Retrieves costs of an allocation group for a period of time, optionally split into steps. " +
+			"Costs are in US dollars, and are zero when the allocation group had no costs in the period. " +
+			"Reading fails when the allocation group doesn't exist.",
+		ReadContext: dataSourceAllocationGroupCostsRead,
+		Schema: allocationGroupReportSchema(map[string]*schema.Schema{
+			FieldAllocationGroupCostsStep: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateDuration,
+				Description:      "Split the reported period into steps of the given length, e.g. `24h`, and return costs of each step in `timeseries`.",
+			},
+			FieldAllocationGroupReportUseListingPrices: allocationGroupUseListingPricesSchema(),
+			FieldAllocationGroupCostsIncludeIdleResources: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Include costs of unallocated node resources, distributed to workloads based on their requests.",
+			},
+			FieldAllocationGroupCostsTotalCost:             costSchema("Total cost of the allocation group."),
+			FieldAllocationGroupCostsTotalCostOnDemand:     costSchema("Cost of on-demand nodes."),
+			FieldAllocationGroupCostsTotalCostSpot:         costSchema("Cost of spot nodes."),
+			FieldAllocationGroupCostsTotalCostSpotFallback: costSchema("Cost of spot fallback nodes."),
+			FieldAllocationGroupCostsCpuCost:               costSchema("Cost of CPU."),
+			FieldAllocationGroupCostsRamCost:               costSchema("Cost of RAM."),
+			FieldAllocationGroupCostsGpuCost:               costSchema("Cost of GPU."),
+			FieldAllocationGroupCostsRequestedCpuHours:     costSchema("Requested CPU hours."),
+			FieldAllocationGroupCostsRequestedRamGibHours:  costSchema("Requested RAM GiB hours."),
+			FieldAllocationGroupCostsTimeseries: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Costs of each step of the reported period. Only set when `step` is configured.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						FieldAllocationGroupCostsTimestamp: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Start of the step in RFC3339 format.",
+						},
+						FieldAllocationGroupCostsTotalCost:             costSchema("Total cost of the allocation group in the step."),
+						FieldAllocationGroupCostsTotalCostOnDemand:     costSchema("Cost of on-demand nodes in the step."),
+						FieldAllocationGroupCostsTotalCostSpot:         costSchema("Cost of spot nodes in the step."),
+						FieldAllocationGroupCostsTotalCostSpotFallback: costSchema("Cost of spot fallback nodes in the step."),
+					},
+				},
+			},
+		}),
+	}
+}
+
+func dataSourceAllocationGroupCostsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+	groupID := d.Get(FieldAllocationGroupReportGroupID).(string)
+
+	start, end, err := allocationGroupReportPeriod(d, time.Now())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.AllocationGroupAPIGetAllocationGroupCostSummariesWithResponse(ctx, &sdk.AllocationGroupAPIGetAllocationGroupCostSummariesParams{
+		StartTime:                start,
+		EndTime:                  end,
+		ClusterIds:               allocationGroupReportClusterIDs(d),
+		GroupId:                  lo.ToPtr(groupID),
+		UseListingPrices:         lo.ToPtr(d.Get(FieldAllocationGroupReportUseListingPrices).(bool)),
+		IncludeIdleResourceCosts: lo.ToPtr(d.Get(FieldAllocationGroupCostsIncludeIdleResources).(bool)),
+	})
+	if checkErr := sdk.CheckOKResponse(resp, err); checkErr != nil {
+		return diag.FromErr(fmt.Errorf("getting allocation group cost summaries: %w", checkErr))
+	}
+
+	group, found := lo.Find(lo.FromPtr(resp.JSON200.Items), func(item sdk.CostreportV1beta1GetAllocationGroupCostSummariesResponseGroupItem) bool {
+		return lo.FromPtr(item.GroupId) == groupID
+	})
+	groupName := lo.FromPtr(group.GroupName)
+	if !found {
+		if groupName, err = getAllocationGroupName(ctx, client, groupID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	summary := lo.FromPtr(group.Summary)
+	costs, err := parseAllocationGroupAmounts(map[string]*string{
+		FieldAllocationGroupCostsTotalCostOnDemand:     summary.TotalCostOnDemand,
+		FieldAllocationGroupCostsTotalCostSpot:         summary.TotalCostSpot,
+		FieldAllocationGroupCostsTotalCostSpotFallback: summary.TotalCostSpotFallback,
+		FieldAllocationGroupCostsCpuCost:               summary.CpuCost,
+		FieldAllocationGroupCostsRamCost:               summary.RamCost,
+		FieldAllocationGroupCostsGpuCost:               summary.GpuCost,
+		FieldAllocationGroupCostsRequestedCpuHours:     summary.RequestedCpuHours,
+		FieldAllocationGroupCostsRequestedRamGibHours:  summary.RequestedRamGibHours,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	costs[FieldAllocationGroupCostsTotalCost] = costs[FieldAllocationGroupCostsTotalCostOnDemand] +
+		costs[FieldAllocationGroupCostsTotalCostSpot] + costs[FieldAllocationGroupCostsTotalCostSpotFallback]
+
+	var timeseries []map[string]any
+	if v, ok := d.GetOk(FieldAllocationGroupCostsStep); ok {
+		step, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("parsing step: %w", err))
+		}
+		if timeseries, err = allocationGroupCostTimeseries(ctx, client, d, start, end, step); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d/%d", groupID, start.Unix(), end.Unix()))
+	if err := d.Set(FieldAllocationGroupReportGroupName, groupName); err != nil {
+		return diag.FromErr(fmt.Errorf("setting group name: %w", err))
+	}
+	for field, value := range costs {
+		if err := d.Set(field, value); err != nil {
+			return diag.FromErr(fmt.Errorf("setting %s: %w", field, err))
+		}
+	}
+	if err := d.Set(FieldAllocationGroupCostsTimeseries, timeseries); err != nil {
+		return diag.FromErr(fmt.Errorf("setting timeseries: %w", err))
+	}
+
+	return nil
+}
+
+// allocationGroupCostTimeseries sums timed cost entries of the allocation group into steps of the reported period.
+// Every step is returned, including the ones without costs.
+func allocationGroupCostTimeseries(
+	ctx context.Context,
+	client sdk.ClientWithResponsesInterface,
+	d *schema.ResourceData,
+	start, end time.Time,
+	step time.Duration,
+) ([]map[string]any, error) {
+	if step <= 0 {
+		return nil, fmt.Errorf("step must be positive, got %s", step)
+	}
+	steps := int((end.Sub(start) + step - 1) / step)
+	if steps > allocationGroupCostsMaxSteps {
+		return nil, fmt.Errorf("step %s splits the reported period into %d steps, at most %d are supported", step, steps, allocationGroupCostsMaxSteps)
+	}
+
+	groupID := d.Get(FieldAllocationGroupReportGroupID).(string)
+	resp, err := client.AllocationGroupAPIGetAllocationGroupCostTimedSummariesWithResponse(ctx, &sdk.AllocationGroupAPIGetAllocationGroupCostTimedSummariesParams{
+		StartTime:                start,
+		EndTime:                  end,
+		ClusterIds:               allocationGroupReportClusterIDs(d),
+		GroupId:                  lo.ToPtr(groupID),
+		UseListingPrices:         lo.ToPtr(d.Get(FieldAllocationGroupReportUseListingPrices).(bool)),
+		IncludeIdleResourceCosts: lo.ToPtr(d.Get(FieldAllocationGroupCostsIncludeIdleResources).(bool)),
+	})
+	if checkErr := sdk.CheckOKResponse(resp, err); checkErr != nil {
+		return nil, fmt.Errorf("getting allocation group timed cost summaries: %w", checkErr)
+	}
+
+	timeseries := make([]map[string]any, steps)
+	for i := range timeseries {
+		timeseries[i] = map[string]any{
+			FieldAllocationGroupCostsTimestamp:             start.Add(time.Duration(i) * step).Format(time.RFC3339),
+			FieldAllocationGroupCostsTotalCost:             0.0,
+			FieldAllocationGroupCostsTotalCostOnDemand:     0.0,
+			FieldAllocationGroupCostsTotalCostSpot:         0.0,
+			FieldAllocationGroupCostsTotalCostSpotFallback: 0.0,
+		}
+	}
+
+	// The allocation group was already confirmed to exist, it's only missing when it had no costs in the period.
+	group, _ := lo.Find(lo.FromPtr(resp.JSON200.Items), func(item sdk.CostreportV1beta1GetAllocationGroupCostTimedSummariesResponseGroupItem) bool {
+		return lo.FromPtr(item.GroupId) == groupID
+	})
+	for _, entry := range lo.FromPtr(group.Items) {
+		timestamp := lo.FromPtr(entry.Timestamp)
+		if timestamp.Before(start) || !timestamp.Before(end) {
+			continue
+		}
+		costs, err := parseAllocationGroupAmounts(map[string]*string{
+			FieldAllocationGroupCostsTotalCostOnDemand:     entry.TotalCostOnDemand,
+			FieldAllocationGroupCostsTotalCostSpot:         entry.TotalCostSpot,
+			FieldAllocationGroupCostsTotalCostSpotFallback: entry.TotalCostSpotFallback,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		bucket := timeseries[int(timestamp.Sub(start)/step)]
+		for field, cost := range costs {
+			bucket[field] = bucket[field].(float64) + cost
+			bucket[FieldAllocationGroupCostsTotalCost] = bucket[FieldAllocationGroupCostsTotalCost].(float64) + cost
+		}
+	}
+
+	return timeseries, nil
+}
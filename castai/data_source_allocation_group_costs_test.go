@@ -0,0 +1,235 @@
+package castai
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+	mock_sdk "github.com/castai/terraform-provider-castai/castai/sdk/mock"
+)
+
+func TestAllocationGroupCostsDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	groupID := "b6bfc074-a267-400f-b8f1-db0850c36ab1"
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(72 * time.Hour)
+
+	t.Run("should read cost summary", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		mockClient.EXPECT().AllocationGroupAPIGetAllocationGroupCostSummaries(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, params *sdk.AllocationGroupAPIGetAllocationGroupCostSummariesParams, _ ...sdk.RequestEditorFn) (*http.Response, error) {
+				r.Equal(start, params.StartTime)
+				r.Equal(end, params.EndTime)
+				r.Equal(groupID, *params.GroupId)
+				r.Equal([]string{"b6bfc074-a267-400f-b8f1-db0850c36ab2"}, *params.ClusterIds)
+				r.True(*params.IncludeIdleResourceCosts)
+				return allocationGroupReportResponse(`{"items": [
+  {"groupId": "other", "groupName": "other", "summary": {"totalCostOnDemand": "1000"}},
+  {"groupId": "` + groupID + `", "groupName": "team-a", "summary": {
+    "totalCostOnDemand": "10.5", "totalCostSpot": "2.25", "totalCostSpotFallback": "0.25",
+    "cpuCost": "8", "ramCost": "5", "requestedCpuHours": "100", "requestedRamGibHours": "400"
+  }}
+]}`), nil
+			})
+
+		data := schema.TestResourceDataRaw(t, dataSourceAllocationGroupCosts().Schema, map[string]any{
+			FieldAllocationGroupReportGroupID:             groupID,
+			FieldAllocationGroupReportClusterIDs:          []any{"b6bfc074-a267-400f-b8f1-db0850c36ab2"},
+			FieldAllocationGroupReportStartTime:           start.Format(time.RFC3339),
+			FieldAllocationGroupReportEndTime:             end.Format(time.RFC3339),
+			FieldAllocationGroupCostsIncludeIdleResources: true,
+		})
+
+		diags := dataSourceAllocationGroupCostsRead(context.Background(), data, provider)
+		r.Nil(diags)
+		r.Equal(groupID+"/1767225600/1767484800", data.Id())
+		r.Equal("team-a", data.Get(FieldAllocationGroupReportGroupName))
+		r.Equal(13.0, data.Get(FieldAllocationGroupCostsTotalCost))
+		r.Equal(2.25, data.Get(FieldAllocationGroupCostsTotalCostSpot))
+		r.Equal(8.0, data.Get(FieldAllocationGroupCostsCpuCost))
+		r.Equal(0.0, data.Get(FieldAllocationGroupCostsGpuCost))
+		r.Equal(400.0, data.Get(FieldAllocationGroupCostsRequestedRamGibHours))
+		r.Empty(data.Get(FieldAllocationGroupCostsTimeseries))
+	})
+
+	t.Run("should split costs into steps", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		mockClient.EXPECT().AllocationGroupAPIGetAllocationGroupCostSummaries(gomock.Any(), gomock.Any()).
+			Return(allocationGroupReportResponse(`{"items": [{"groupId": "`+groupID+`", "summary": {"totalCostOnDemand": "6"}}]}`), nil)
+		mockClient.EXPECT().AllocationGroupAPIGetAllocationGroupCostTimedSummaries(gomock.Any(), gomock.Any()).
+			Return(allocationGroupReportResponse(`{"items": [{"groupId": "`+groupID+`", "items": [
+  {"timestamp": "2026-01-01T00:00:00Z", "totalCostOnDemand": "1"},
+  {"timestamp": "2026-01-01T12:00:00Z", "totalCostOnDemand": "2", "totalCostSpot": "0.5"},
+  {"timestamp": "2026-01-03T00:00:00Z", "totalCostOnDemand": "3"},
+  {"timestamp": "2026-01-04T00:00:00Z", "totalCostOnDemand": "100"}
+]}]}`), nil)
+
+		data := schema.TestResourceDataRaw(t, dataSourceAllocationGroupCosts().Schema, map[string]any{
+			FieldAllocationGroupReportGroupID: groupID,
+			FieldAllocationGroupReportEndTime: end.Format(time.RFC3339),
+			FieldAllocationGroupReportWindow:  "72h",
+			FieldAllocationGroupCostsStep:     "24h",
+		})
+
+		diags := dataSourceAllocationGroupCostsRead(context.Background(), data, provider)
+		r.Nil(diags)
+		r.Equal(3, data.Get(FieldAllocationGroupCostsTimeseries+".#"))
+		r.Equal(map[string]any{
+			FieldAllocationGroupCostsTimestamp:             "2026-01-01T00:00:00Z",
+			FieldAllocationGroupCostsTotalCost:             3.5,
+			FieldAllocationGroupCostsTotalCostOnDemand:     3.0,
+			FieldAllocationGroupCostsTotalCostSpot:         0.5,
+			FieldAllocationGroupCostsTotalCostSpotFallback: 0.0,
+		}, data.Get(FieldAllocationGroupCostsTimeseries+".0"))
+		r.Equal(0.0, data.Get(FieldAllocationGroupCostsTimeseries+".1."+FieldAllocationGroupCostsTotalCost))
+		r.Equal(3.0, data.Get(FieldAllocationGroupCostsTimeseries+".2."+FieldAllocationGroupCostsTotalCost))
+	})
+
+	t.Run("should report zero costs of a group missing from the summary", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		mockClient.EXPECT().AllocationGroupAPIGetAllocationGroupCostSummaries(gomock.Any(), gomock.Any()).
+			Return(allocationGroupReportResponse(`{"items": [{"groupId": "other", "summary": {"totalCostOnDemand": "1000"}}]}`), nil)
+		mockClient.EXPECT().AllocationGroupAPIGetAllocationGroup(gomock.Any(), groupID).
+			Return(allocationGroupReportResponse(`{"id": "`+groupID+`", "name": "team-a"}`), nil)
+
+		data := schema.TestResourceDataRaw(t, dataSourceAllocationGroupCosts().Schema, map[string]any{
+			FieldAllocationGroupReportGroupID: groupID,
+		})
+
+		diags := dataSourceAllocationGroupCostsRead(context.Background(), data, provider)
+		r.Nil(diags)
+		r.Equal("team-a", data.Get(FieldAllocationGroupReportGroupName))
+		r.Equal(0.0, data.Get(FieldAllocationGroupCostsTotalCost))
+	})
+
+	t.Run("should fail when the group doesn't exist", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		notFound := allocationGroupReportResponse(`{"message": "not found"}`)
+		notFound.StatusCode = http.StatusNotFound
+		mockClient.EXPECT().AllocationGroupAPIGetAllocationGroupCostSummaries(gomock.Any(), gomock.Any()).
+			Return(allocationGroupReportResponse(`{"items": []}`), nil)
+		mockClient.EXPECT().AllocationGroupAPIGetAllocationGroup(gomock.Any(), groupID).
+			Return(notFound, nil)
+
+		data := schema.TestResourceDataRaw(t, dataSourceAllocationGroupCosts().Schema, map[string]any{
+			FieldAllocationGroupReportGroupID: groupID,
+		})
+
+		diags := dataSourceAllocationGroupCostsRead(context.Background(), data, provider)
+		r.True(diags.HasError())
+		r.Equal("allocation group "+groupID+" not found", diags[0].Summary)
+	})
+
+	t.Run("should reject too many steps", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		mockClient.EXPECT().AllocationGroupAPIGetAllocationGroupCostSummaries(gomock.Any(), gomock.Any()).
+			Return(allocationGroupReportResponse(`{"items": []}`), nil)
+		mockClient.EXPECT().AllocationGroupAPIGetAllocationGroup(gomock.Any(), groupID).
+			Return(allocationGroupReportResponse(`{"id": "`+groupID+`", "name": "team-a"}`), nil)
+
+		data := schema.TestResourceDataRaw(t, dataSourceAllocationGroupCosts().Schema, map[string]any{
+			FieldAllocationGroupReportGroupID: groupID,
+			FieldAllocationGroupCostsStep:     "1m",
+		})
+
+		diags := dataSourceAllocationGroupCostsRead(context.Background(), data, provider)
+		r.True(diags.HasError())
+		r.Contains(diags[0].Summary, "at most 1000 are supported")
+	})
+}
+
+func TestAllocationGroupReportPeriod(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 2, 1, 12, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		raw       map[string]any
+		wantStart time.Time
+		wantEnd   time.Time
+		wantErr   string
+	}{
+		"defaults to 30 days before now": {
+			raw:       map[string]any{},
+			wantStart: now.Add(-720 * time.Hour),
+			wantEnd:   now,
+		},
+		"window before end time": {
+			raw: map[string]any{
+				FieldAllocationGroupReportEndTime: "2026-01-10T00:00:00Z",
+				FieldAllocationGroupReportWindow:  "48h",
+			},
+			wantStart: time.Date(2026, 1, 8, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC),
+		},
+		"start time": {
+			raw: map[string]any{
+				FieldAllocationGroupReportStartTime: "2026-01-01T00:00:00Z",
+			},
+			wantStart: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   now,
+		},
+		"start after end": {
+			raw: map[string]any{
+				FieldAllocationGroupReportStartTime: "2026-01-10T00:00:00Z",
+				FieldAllocationGroupReportEndTime:   "2026-01-01T00:00:00Z",
+			},
+			wantErr: "must be before its end",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			r := require.New(t)
+
+			tt.raw[FieldAllocationGroupReportGroupID] = "b6bfc074-a267-400f-b8f1-db0850c36ab1"
+			data := schema.TestResourceDataRaw(t, dataSourceAllocationGroupCosts().Schema, tt.raw)
+
+			start, end, err := allocationGroupReportPeriod(data, now)
+			if tt.wantErr != "" {
+				r.ErrorContains(err, tt.wantErr)
+				return
+			}
+			r.NoError(err)
+			r.Equal(tt.wantStart, start)
+			r.Equal(tt.wantEnd, end)
+		})
+	}
+}
+
+func allocationGroupReportResponse(body string) *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+		Header:     map[string][]string{"Content-Type": {"json"}},
+	}
+}
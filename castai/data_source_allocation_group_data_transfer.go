@@ -0,0 +1,244 @@
+package castai
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+)
+
+const (
+	FieldAllocationGroupDataTransferTopWorkloadsCount = "top_workloads_count"
+	FieldAllocationGroupDataTransferTopWorkloads      = "top_workloads"
+	FieldAllocationGroupDataTransferTotalCost         = "total_cost"
+	FieldAllocationGroupDataTransferInternetBytes     = "internet_bytes"
+	FieldAllocationGroupDataTransferInternetCost      = "internet_cost"
+	FieldAllocationGroupDataTransferCrossZoneBytes    = "cross_zone_bytes"
+	FieldAllocationGroupDataTransferCrossZoneCost     = "cross_zone_cost"
+	FieldAllocationGroupDataTransferCrossRegionBytes  = "cross_region_bytes"
+	FieldAllocationGroupDataTransferCrossRegionCost   = "cross_region_cost"
+	FieldAllocationGroupDataTransferIntraZoneBytes    = "intra_zone_bytes"
+	FieldAllocationGroupDataTransferIntraZoneCost     = "intra_zone_cost"
+	FieldAllocationGroupDataTransferCloudAPIBytes     = "cloud_api_bytes"
+	FieldAllocationGroupDataTransferCloudAPICost      = "cloud_api_cost"
+	FieldAllocationGroupDataTransferClusterID         = "cluster_id"
+	FieldAllocationGroupDataTransferNamespace         = "namespace"
+	FieldAllocationGroupDataTransferWorkloadName      = "workload_name"
+	FieldAllocationGroupDataTransferWorkloadType      = "workload_type"
+)
+
+// allocationGroupDataTransferCostFields are the cost attributes summed into total_cost.
+var allocationGroupDataTransferCostFields = []string{
+	FieldAllocationGroupDataTransferInternetCost,
+	FieldAllocationGroupDataTransferCrossZoneCost,
+	FieldAllocationGroupDataTransferCrossRegionCost,
+	FieldAllocationGroupDataTransferIntraZoneCost,
+	FieldAllocationGroupDataTransferCloudAPICost,
+}
+
+func dataSourceAllocationGroupDataTransfer() *schema.Resource {
+	workloadSchema := allocationGroupDataTransferSchema("the workload")
+	for key, s := range map[string]*schema.Schema{
+		FieldAllocationGroupDataTransferClusterID:    {Type: schema.TypeString, Computed: true, Description: "CAST AI cluster ID of the workload."},
+		FieldAllocationGroupDataTransferNamespace:    {Type: schema.TypeString, Computed: true, Description: "Namespace of the workload."},
+		FieldAllocationGroupDataTransferWorkloadName: {Type: schema.TypeString, Computed: true, Description: "Name of the workload."},
+		FieldAllocationGroupDataTransferWorkloadType: {Type: schema.TypeString, Computed: true, Description: "Type of the workload, e.g. `Deployment`."},
+	} {
+		workloadSchema[key] = s
+	}
+
+	reportSchema := allocationGroupDataTransferSchema("workloads of the allocation group")
+	reportSchema[FieldAllocationGroupDataTransferTopWorkloadsCount] = &schema.Schema{
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          10,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+		Description:      "Number of workloads with the highest data transfer cost to return in `top_workloads`. Set to 0 to skip fetching workloads.",
+	}
+	reportSchema[FieldAllocationGroupDataTransferTopWorkloads] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Workloads of the allocation group with the highest data transfer cost, in descending order of `total_cost`.",
+		Elem: &schema.Resource{
+			Schema: workloadSchema,
+		},
+	}
+
+	return &schema.Resource{
+		Description: "Retrieves data transfer volume and costs of an allocation group for a period of time. " +
+			"Traffic is attributed to the workloads sending it, costs are in US dollars. Values are zero when the allocation " +
+			"group sent no traffic in the period, and reading fails when the allocation group doesn't exist.",
+		ReadContext: dataSourceAllocationGroupDataTransferRead,
+		Schema:      allocationGroupReportSchema(reportSchema),
+	}
+}
+
+func allocationGroupDataTransferSchema(subject string) map[string]*schema.Schema {
+	metric := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: description + " by " + subject + ".",
+		}
+	}
+
+	return map[string]*schema.Schema{
+		FieldAllocationGroupDataTransferTotalCost:        metric("Total cost of data sent"),
+		FieldAllocationGroupDataTransferInternetBytes:    metric("Bytes sent to the internet"),
+		FieldAllocationGroupDataTransferInternetCost:     metric("Cost of data sent to the internet"),
+		FieldAllocationGroupDataTransferCrossZoneBytes:   metric("Bytes sent to other zones"),
+		FieldAllocationGroupDataTransferCrossZoneCost:    metric("Cost of data sent to other zones"),
+		FieldAllocationGroupDataTransferCrossRegionBytes: metric("Bytes sent to other regions"),
+		FieldAllocationGroupDataTransferCrossRegionCost:  metric("Cost of data sent to other regions"),
+		FieldAllocationGroupDataTransferIntraZoneBytes:   metric("Bytes sent within the zone"),
+		FieldAllocationGroupDataTransferIntraZoneCost:    metric("Cost of data sent within the zone"),
+		FieldAllocationGroupDataTransferCloudAPIBytes:    metric("Bytes sent to cloud provider APIs"),
+		FieldAllocationGroupDataTransferCloudAPICost:     metric("Cost of data sent to cloud provider APIs"),
+	}
+}
+
+func dataSourceAllocationGroupDataTransferRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+	groupID := d.Get(FieldAllocationGroupReportGroupID).(string)
+
+	start, end, err := allocationGroupReportPeriod(d, time.Now())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The summary can't be filtered by group, it lists all groups of the organization.
+	resp, err := client.AllocationGroupAPIGetCostAllocationGroupDataTransferSummaryWithResponse(ctx, &sdk.AllocationGroupAPIGetCostAllocationGroupDataTransferSummaryParams{
+		StartTime:  start,
+		EndTime:    end,
+		ClusterIds: allocationGroupReportClusterIDs(d),
+	})
+	if checkErr := sdk.CheckOKResponse(resp, err); checkErr != nil {
+		return diag.FromErr(fmt.Errorf("getting allocation group data transfer summary: %w", checkErr))
+	}
+
+	group, found := lo.Find(lo.FromPtr(resp.JSON200.Groups), func(item sdk.CostreportV1beta1GetCostAllocationGroupDataTransferSummaryResponseCostAllocationGroupItem) bool {
+		return lo.FromPtr(item.GroupId) == groupID
+	})
+	groupName := lo.FromPtr(group.GroupName)
+	if !found {
+		if groupName, err = getAllocationGroupName(ctx, client, groupID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	summary := make(map[string]float64)
+	for _, entry := range lo.FromPtr(group.Items) {
+		metrics, err := parseDataTransferMetrics(lo.FromPtr(entry.EgressMetrics))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for field, value := range metrics {
+			summary[field] += value
+		}
+	}
+
+	var topWorkloads []map[string]any
+	if count := d.Get(FieldAllocationGroupDataTransferTopWorkloadsCount).(int); count > 0 {
+		if topWorkloads, err = allocationGroupDataTransferTopWorkloads(ctx, client, d, start, end, count); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d/%d", groupID, start.Unix(), end.Unix()))
+	if err := d.Set(FieldAllocationGroupReportGroupName, groupName); err != nil {
+		return diag.FromErr(fmt.Errorf("setting group name: %w", err))
+	}
+	for field := range allocationGroupDataTransferSchema("") {
+		if err := d.Set(field, summary[field]); err != nil {
+			return diag.FromErr(fmt.Errorf("setting %s: %w", field, err))
+		}
+	}
+	if err := d.Set(FieldAllocationGroupDataTransferTopWorkloads, topWorkloads); err != nil {
+		return diag.FromErr(fmt.Errorf("setting top workloads: %w", err))
+	}
+
+	return nil
+}
+
+// allocationGroupDataTransferTopWorkloads returns up to count workloads of the allocation group with the highest data
+// transfer cost. The workloads API doesn't filter by cluster, so the workloads are filtered by cluster_ids here.
+func allocationGroupDataTransferTopWorkloads(
+	ctx context.Context,
+	client sdk.ClientWithResponsesInterface,
+	d *schema.ResourceData,
+	start, end time.Time,
+	count int,
+) ([]map[string]any, error) {
+	groupID := d.Get(FieldAllocationGroupReportGroupID).(string)
+	resp, err := client.AllocationGroupAPIGetCostAllocationGroupDataTransferWorkloadsWithResponse(ctx, groupID, &sdk.AllocationGroupAPIGetCostAllocationGroupDataTransferWorkloadsParams{
+		StartTime: start,
+		EndTime:   end,
+	})
+	if checkErr := sdk.CheckOKResponse(resp, err); checkErr != nil {
+		return nil, fmt.Errorf("getting allocation group data transfer workloads: %w", checkErr)
+	}
+
+	clusterIDs := lo.FromPtr(allocationGroupReportClusterIDs(d))
+	var workloads []map[string]any
+	for _, cluster := range lo.FromPtr(resp.JSON200.Clusters) {
+		clusterID := lo.FromPtr(cluster.ClusterId)
+		if len(clusterIDs) > 0 && !lo.Contains(clusterIDs, clusterID) {
+			continue
+		}
+		for _, item := range lo.FromPtr(cluster.Items) {
+			metrics, err := parseDataTransferMetrics(sdk.CostreportV1beta1GetCostAllocationGroupDataTransferSummaryResponseDataTransferCostItem(lo.FromPtr(item.EgressMetrics)))
+			if err != nil {
+				return nil, err
+			}
+			workload := map[string]any{
+				FieldAllocationGroupDataTransferClusterID:    clusterID,
+				FieldAllocationGroupDataTransferNamespace:    lo.FromPtr(item.Namespace),
+				FieldAllocationGroupDataTransferWorkloadName: lo.FromPtr(item.WorkloadName),
+				FieldAllocationGroupDataTransferWorkloadType: lo.FromPtr(item.WorkloadType),
+			}
+			for field, value := range metrics {
+				workload[field] = value
+			}
+			workloads = append(workloads, workload)
+		}
+	}
+
+	sort.SliceStable(workloads, func(i, j int) bool {
+		return workloads[i][FieldAllocationGroupDataTransferTotalCost].(float64) > workloads[j][FieldAllocationGroupDataTransferTotalCost].(float64)
+	})
+	if len(workloads) > count {
+		workloads = workloads[:count]
+	}
+
+	return workloads, nil
+}
+
+// parseDataTransferMetrics returns data transfer volume and costs keyed by attribute, including their total cost.
+func parseDataTransferMetrics(metrics sdk.CostreportV1beta1GetCostAllocationGroupDataTransferSummaryResponseDataTransferCostItem) (map[string]float64, error) {
+	out, err := parseAllocationGroupAmounts(map[string]*string{
+		FieldAllocationGroupDataTransferInternetBytes:    metrics.InternetBytes,
+		FieldAllocationGroupDataTransferInternetCost:     metrics.InternetCost,
+		FieldAllocationGroupDataTransferCrossZoneBytes:   metrics.InterZoneBytes,
+		FieldAllocationGroupDataTransferCrossZoneCost:    metrics.InterZoneCost,
+		FieldAllocationGroupDataTransferCrossRegionBytes: metrics.InterRegionBytes,
+		FieldAllocationGroupDataTransferCrossRegionCost:  metrics.InterRegionCost,
+		FieldAllocationGroupDataTransferIntraZoneBytes:   metrics.IntraZoneBytes,
+		FieldAllocationGroupDataTransferIntraZoneCost:    metrics.IntraZoneCost,
+		FieldAllocationGroupDataTransferCloudAPIBytes:    metrics.CloudApiBytes,
+		FieldAllocationGroupDataTransferCloudAPICost:     metrics.CloudApiCost,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range allocationGroupDataTransferCostFields {
+		out[FieldAllocationGroupDataTransferTotalCost] += out[field]
+	}
+
+	return out, nil
+}
@@ -0,0 +1,116 @@
+package castai
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+	mock_sdk "github.com/castai/terraform-provider-castai/castai/sdk/mock"
+)
+
+func TestAllocationGroupDataTransferDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	groupID := "b6bfc074-a267-400f-b8f1-db0850c36ad1"
+	clusterID := "b6bfc074-a267-400f-b8f1-db0850c36ad2"
+	summary := `{"groups": [
+  {"groupId": "other", "items": [{"egressMetrics": {"internetCost": "1000"}}]},
+  {"groupId": "` + groupID + `", "groupName": "team-a", "items": [
+    {"timestamp": "2026-01-01T00:00:00Z", "egressMetrics": {"internetBytes": "1000", "internetCost": "1.5", "interZoneBytes": "4000", "interZoneCost": "2"}, "ingressMetrics": {"internetCost": "100"}},
+    {"timestamp": "2026-01-02T00:00:00Z", "egressMetrics": {"interZoneBytes": "1000", "interZoneCost": "0.5", "cloudApiCost": "0.25"}}
+  ]}
+]}`
+
+	t.Run("should sum egress of the group and return top workloads", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		mockClient.EXPECT().AllocationGroupAPIGetCostAllocationGroupDataTransferSummary(gomock.Any(), gomock.Any()).
+			Return(allocationGroupReportResponse(summary), nil)
+		mockClient.EXPECT().AllocationGroupAPIGetCostAllocationGroupDataTransferWorkloads(gomock.Any(), groupID, gomock.Any()).
+			Return(allocationGroupReportResponse(`{"clusters": [
+  {"clusterId": "`+clusterID+`", "items": [
+    {"namespace": "default", "workloadName": "cheap", "workloadType": "Deployment", "egressMetrics": {"internetCost": "0.1"}},
+    {"namespace": "default", "workloadName": "expensive", "workloadType": "StatefulSet", "egressMetrics": {"interZoneBytes": "5000", "interZoneCost": "2.5"}},
+    {"namespace": "jobs", "workloadName": "middle", "workloadType": "Job", "egressMetrics": {"internetCost": "1"}}
+  ]},
+  {"clusterId": "b6bfc074-a267-400f-b8f1-db0850c36ad3", "items": [
+    {"namespace": "default", "workloadName": "filtered", "egressMetrics": {"internetCost": "100"}}
+  ]}
+]}`), nil)
+
+		data := schema.TestResourceDataRaw(t, dataSourceAllocationGroupDataTransfer().Schema, map[string]any{
+			FieldAllocationGroupReportGroupID:                 groupID,
+			FieldAllocationGroupReportClusterIDs:              []any{clusterID},
+			FieldAllocationGroupDataTransferTopWorkloadsCount: 2,
+		})
+
+		diags := dataSourceAllocationGroupDataTransferRead(context.Background(), data, provider)
+		r.Nil(diags)
+		r.Equal("team-a", data.Get(FieldAllocationGroupReportGroupName))
+		r.Equal(4.25, data.Get(FieldAllocationGroupDataTransferTotalCost))
+		r.Equal(1000.0, data.Get(FieldAllocationGroupDataTransferInternetBytes))
+		r.Equal(5000.0, data.Get(FieldAllocationGroupDataTransferCrossZoneBytes))
+		r.Equal(2.5, data.Get(FieldAllocationGroupDataTransferCrossZoneCost))
+		r.Equal(0.0, data.Get(FieldAllocationGroupDataTransferCrossRegionCost))
+
+		r.Equal(2, data.Get(FieldAllocationGroupDataTransferTopWorkloads+".#"))
+		top := data.Get(FieldAllocationGroupDataTransferTopWorkloads + ".0").(map[string]any)
+		r.Equal("expensive", top[FieldAllocationGroupDataTransferWorkloadName])
+		r.Equal("StatefulSet", top[FieldAllocationGroupDataTransferWorkloadType])
+		r.Equal(clusterID, top[FieldAllocationGroupDataTransferClusterID])
+		r.Equal(2.5, top[FieldAllocationGroupDataTransferTotalCost])
+		r.Equal(5000.0, top[FieldAllocationGroupDataTransferCrossZoneBytes])
+		r.Equal("middle", data.Get(FieldAllocationGroupDataTransferTopWorkloads+".1."+FieldAllocationGroupDataTransferWorkloadName))
+	})
+
+	t.Run("should skip workloads when top workloads count is 0", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		mockClient.EXPECT().AllocationGroupAPIGetCostAllocationGroupDataTransferSummary(gomock.Any(), gomock.Any()).
+			Return(allocationGroupReportResponse(summary), nil)
+
+		data := schema.TestResourceDataRaw(t, dataSourceAllocationGroupDataTransfer().Schema, map[string]any{
+			FieldAllocationGroupReportGroupID:                 groupID,
+			FieldAllocationGroupDataTransferTopWorkloadsCount: 0,
+		})
+
+		diags := dataSourceAllocationGroupDataTransferRead(context.Background(), data, provider)
+		r.Nil(diags)
+		r.Equal(4.25, data.Get(FieldAllocationGroupDataTransferTotalCost))
+		r.Empty(data.Get(FieldAllocationGroupDataTransferTopWorkloads))
+	})
+	t.Run("should fail when the group doesn't exist", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		missingGroupID := "b6bfc074-a267-400f-b8f1-db0850c36ad9"
+		notFound := allocationGroupReportResponse(`{"message": "not found"}`)
+		notFound.StatusCode = http.StatusNotFound
+		mockClient.EXPECT().AllocationGroupAPIGetCostAllocationGroupDataTransferSummary(gomock.Any(), gomock.Any()).
+			Return(allocationGroupReportResponse(summary), nil)
+		mockClient.EXPECT().AllocationGroupAPIGetAllocationGroup(gomock.Any(), missingGroupID).
+			Return(notFound, nil)
+
+		data := schema.TestResourceDataRaw(t, dataSourceAllocationGroupDataTransfer().Schema, map[string]any{
+			FieldAllocationGroupReportGroupID:                 missingGroupID,
+			FieldAllocationGroupDataTransferTopWorkloadsCount: 0,
+		})
+
+		diags := dataSourceAllocationGroupDataTransferRead(context.Background(), data, provider)
+		r.True(diags.HasError())
+		r.Equal("allocation group "+missingGroupID+" not found", diags[0].Summary)
+	})
+}
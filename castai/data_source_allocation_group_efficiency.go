@@ -0,0 +1,125 @@
+package castai
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/samber/lo"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+)
+
+const (
+	FieldAllocationGroupEfficiencyTotalCostImpact        = "total_cost_impact"
+	FieldAllocationGroupEfficiencyCostImpactOnDemand     = "cost_impact_on_demand"
+	FieldAllocationGroupEfficiencyCostImpactSpot         = "cost_impact_spot"
+	FieldAllocationGroupEfficiencyCostImpactSpotFallback = "cost_impact_spot_fallback"
+	FieldAllocationGroupEfficiencyRequestedCpu           = "requested_cpu"
+	FieldAllocationGroupEfficiencyRequestedMemoryGib     = "requested_memory_gib"
+	FieldAllocationGroupEfficiencyUsedCpu                = "used_cpu"
+	FieldAllocationGroupEfficiencyUsedMemoryGib          = "used_memory_gib"
+	FieldAllocationGroupEfficiencyCpuEfficiency          = "cpu_efficiency"
+	FieldAllocationGroupEfficiencyMemoryEfficiency       = "memory_efficiency"
+)
+
+func dataSourceAllocationGroupEfficiency() *schema.Resource {
+	floatSchema := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: description,
+		}
+	}
+
+	return &schema.Resource{
+		Description: "Retrieves resource efficiency of an allocation group for a period of time. " +
+			"Cost impact is the cost of requested but unused resources in US dollars. Efficiency is reported for the whole " +
+			"period only, use `castai_allocation_group_costs` for costs split into steps. Values are zero when the allocation " +
+			"group had nothing to report in the period, and reading fails when the allocation group doesn't exist.",
+		ReadContext: dataSourceAllocationGroupEfficiencyRead,
+		Schema: allocationGroupReportSchema(map[string]*schema.Schema{
+			FieldAllocationGroupReportUseListingPrices:           allocationGroupUseListingPricesSchema(),
+			FieldAllocationGroupEfficiencyTotalCostImpact:        floatSchema("Total cost of requested but unused resources."),
+			FieldAllocationGroupEfficiencyCostImpactOnDemand:     floatSchema("Cost of requested but unused resources on on-demand nodes."),
+			FieldAllocationGroupEfficiencyCostImpactSpot:         floatSchema("Cost of requested but unused resources on spot nodes."),
+			FieldAllocationGroupEfficiencyCostImpactSpotFallback: floatSchema("Cost of requested but unused resources on spot fallback nodes."),
+			FieldAllocationGroupEfficiencyRequestedCpu:           floatSchema("Average requested CPU."),
+			FieldAllocationGroupEfficiencyRequestedMemoryGib:     floatSchema("Average requested memory in GiB."),
+			FieldAllocationGroupEfficiencyUsedCpu:                floatSchema("Average used CPU."),
+			FieldAllocationGroupEfficiencyUsedMemoryGib:          floatSchema("Average used memory in GiB."),
+			FieldAllocationGroupEfficiencyCpuEfficiency:          floatSchema("Ratio of used to requested CPU, 0 when nothing is requested."),
+			FieldAllocationGroupEfficiencyMemoryEfficiency:       floatSchema("Ratio of used to requested memory, 0 when nothing is requested."),
+		}),
+	}
+}
+
+func dataSourceAllocationGroupEfficiencyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+	groupID := d.Get(FieldAllocationGroupReportGroupID).(string)
+
+	start, end, err := allocationGroupReportPeriod(d, time.Now())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The summary can't be filtered by group, it lists all groups of the organization.
+	resp, err := client.AllocationGroupAPIGetAllocationGroupEfficiencySummaryWithResponse(ctx, &sdk.AllocationGroupAPIGetAllocationGroupEfficiencySummaryParams{
+		StartTime:        start,
+		EndTime:          end,
+		ClusterIds:       allocationGroupReportClusterIDs(d),
+		UseListingPrices: lo.ToPtr(d.Get(FieldAllocationGroupReportUseListingPrices).(bool)),
+	})
+	if checkErr := sdk.CheckOKResponse(resp, err); checkErr != nil {
+		return diag.FromErr(fmt.Errorf("getting allocation group efficiency summary: %w", checkErr))
+	}
+
+	group, found := lo.Find(lo.FromPtr(resp.JSON200.Items), func(item sdk.CostreportV1beta1GetAllocationGroupEfficiencySummaryResponseCostAllocationGroupItem) bool {
+		return lo.FromPtr(item.GroupId) == groupID
+	})
+	groupName := lo.FromPtr(group.GroupName)
+	if !found {
+		if groupName, err = getAllocationGroupName(ctx, client, groupID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	costImpact := lo.FromPtr(group.CostImpact)
+	requests := lo.FromPtr(group.Requests)
+	usage := lo.FromPtr(group.Usage)
+	values, err := parseAllocationGroupAmounts(map[string]*string{
+		FieldAllocationGroupEfficiencyTotalCostImpact:        group.TotalCostImpact,
+		FieldAllocationGroupEfficiencyCostImpactOnDemand:     &costImpact.OnDemand,
+		FieldAllocationGroupEfficiencyCostImpactSpot:         &costImpact.Spot,
+		FieldAllocationGroupEfficiencyCostImpactSpotFallback: &costImpact.SpotFallback,
+		FieldAllocationGroupEfficiencyRequestedCpu:           &requests.Cpu,
+		FieldAllocationGroupEfficiencyRequestedMemoryGib:     &requests.MemoryGib,
+		FieldAllocationGroupEfficiencyUsedCpu:                &usage.Cpu,
+		FieldAllocationGroupEfficiencyUsedMemoryGib:          &usage.MemoryGib,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	values[FieldAllocationGroupEfficiencyCpuEfficiency] = efficiencyRatio(values[FieldAllocationGroupEfficiencyUsedCpu], values[FieldAllocationGroupEfficiencyRequestedCpu])
+	values[FieldAllocationGroupEfficiencyMemoryEfficiency] = efficiencyRatio(values[FieldAllocationGroupEfficiencyUsedMemoryGib], values[FieldAllocationGroupEfficiencyRequestedMemoryGib])
+
+	d.SetId(fmt.Sprintf("%s/%d/%d", groupID, start.Unix(), end.Unix()))
+	if err := d.Set(FieldAllocationGroupReportGroupName, groupName); err != nil {
+		return diag.FromErr(fmt.Errorf("setting group name: %w", err))
+	}
+	for field, value := range values {
+		if err := d.Set(field, value); err != nil {
+			return diag.FromErr(fmt.Errorf("setting %s: %w", field, err))
+		}
+	}
+
+	return nil
+}
+
+func efficiencyRatio(used, requested float64) float64 {
+	if requested == 0 {
+		return 0
+	}
+	return used / requested
+}
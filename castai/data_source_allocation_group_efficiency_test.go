@@ -0,0 +1,80 @@
+package castai
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+	mock_sdk "github.com/castai/terraform-provider-castai/castai/sdk/mock"
+)
+
+func TestAllocationGroupEfficiencyDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+	provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+	groupID := "b6bfc074-a267-400f-b8f1-db0850c36ac1"
+	mockClient.EXPECT().AllocationGroupAPIGetAllocationGroupEfficiencySummary(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, params *sdk.AllocationGroupAPIGetAllocationGroupEfficiencySummaryParams, _ ...sdk.RequestEditorFn) (*http.Response, error) {
+			r.True(*params.UseListingPrices)
+			r.Nil(params.ClusterIds)
+			return allocationGroupReportResponse(`{"items": [
+  {"groupId": "other", "groupName": "other", "totalCostImpact": "1000"},
+  {
+    "groupId": "` + groupID + `",
+    "groupName": "team-a",
+    "totalCostImpact": "12.5",
+    "costImpact": {"onDemand": "10", "spot": "2.5", "spotFallback": "0"},
+    "requests": {"cpu": "8", "memoryGib": "0"},
+    "usage": {"cpu": "2", "memoryGib": "3"}
+  }
+]}`), nil
+		})
+
+	data := schema.TestResourceDataRaw(t, dataSourceAllocationGroupEfficiency().Schema, map[string]any{
+		FieldAllocationGroupReportGroupID:          groupID,
+		FieldAllocationGroupReportWindow:           "24h",
+		FieldAllocationGroupReportUseListingPrices: true,
+	})
+
+	diags := dataSourceAllocationGroupEfficiencyRead(context.Background(), data, provider)
+	r.Nil(diags)
+	r.Equal("team-a", data.Get(FieldAllocationGroupReportGroupName))
+	r.Equal(12.5, data.Get(FieldAllocationGroupEfficiencyTotalCostImpact))
+	r.Equal(2.5, data.Get(FieldAllocationGroupEfficiencyCostImpactSpot))
+	r.Equal(8.0, data.Get(FieldAllocationGroupEfficiencyRequestedCpu))
+	r.Equal(3.0, data.Get(FieldAllocationGroupEfficiencyUsedMemoryGib))
+	r.Equal(0.25, data.Get(FieldAllocationGroupEfficiencyCpuEfficiency))
+	r.Equal(0.0, data.Get(FieldAllocationGroupEfficiencyMemoryEfficiency))
+}
+
+func TestAllocationGroupEfficiencyDataSourceRead_missingGroup(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+	provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+	groupID := "b6bfc074-a267-400f-b8f1-db0850c36ac2"
+	notFound := allocationGroupReportResponse(`{"message": "not found"}`)
+	notFound.StatusCode = http.StatusNotFound
+	mockClient.EXPECT().AllocationGroupAPIGetAllocationGroupEfficiencySummary(gomock.Any(), gomock.Any()).
+		Return(allocationGroupReportResponse(`{"items": [{"groupId": "other", "groupName": "other", "totalCostImpact": "1000"}]}`), nil)
+	mockClient.EXPECT().AllocationGroupAPIGetAllocationGroup(gomock.Any(), groupID).
+		Return(notFound, nil)
+
+	data := schema.TestResourceDataRaw(t, dataSourceAllocationGroupEfficiency().Schema, map[string]any{
+		FieldAllocationGroupReportGroupID: groupID,
+	})
+
+	diags := dataSourceAllocationGroupEfficiencyRead(context.Background(), data, provider)
+	r.True(diags.HasError())
+	r.Equal("allocation group "+groupID+" not found", diags[0].Summary)
+}
@@ -0,0 +1,119 @@
+package castai
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+)
+
+const (
+	FieldAutoscalerNodeConstraintsMinCpu       = "min_cpu"
+	FieldAutoscalerNodeConstraintsMaxCpu       = "max_cpu"
+	FieldAutoscalerNodeConstraintsMinMemory    = "min_memory"
+	FieldAutoscalerNodeConstraintsMaxMemory    = "max_memory"
+	FieldAutoscalerNodeConstraintsCombinations = "combinations"
+	FieldAutoscalerNodeConstraintsCpu          = "cpu"
+	FieldAutoscalerNodeConstraintsMemory       = "memory"
+)
+
+func dataSourceAutoscalerNodeConstraints() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieves CPU and memory bounds the autoscaler effectively uses when picking nodes for a cluster. " +
+			"Allowed instance families aren't returned by the API, use the `castai_instance_types` data source to check which instance types node template constraints match.",
+		ReadContext: dataSourceAutoscalerNodeConstraintsRead,
+		Schema: map[string]*schema.Schema{
+			FieldClusterID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "CAST AI cluster id.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsUUID),
+			},
+			FieldAutoscalerNodeConstraintsMinCpu: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Lowest number of CPU cores of a node the autoscaler can pick.",
+			},
+			FieldAutoscalerNodeConstraintsMaxCpu: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Highest number of CPU cores of a node the autoscaler can pick.",
+			},
+			FieldAutoscalerNodeConstraintsMinMemory: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Lowest memory in MiB of a node the autoscaler can pick.",
+			},
+			FieldAutoscalerNodeConstraintsMaxMemory: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Highest memory in MiB of a node the autoscaler can pick.",
+			},
+			FieldAutoscalerNodeConstraintsCombinations: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Viable CPU and memory combinations of nodes the autoscaler can pick.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						FieldAutoscalerNodeConstraintsCpu: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of CPU cores.",
+						},
+						FieldAutoscalerNodeConstraintsMemory: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Memory in MiB.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAutoscalerNodeConstraintsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+	clusterID := d.Get(FieldClusterID).(string)
+
+	resp, err := client.PoliciesAPIGetClusterNodeConstraintsWithResponse(ctx, clusterID)
+	if checkErr := sdk.CheckOKResponse(resp, err); checkErr != nil {
+		return diag.FromErr(fmt.Errorf("getting cluster node constraints: %w", checkErr))
+	}
+
+	items := lo.FromPtr(resp.JSON200.Items)
+	combinations := lo.Map(items, func(item sdk.PoliciesV1GetClusterNodeConstraintsResponseCpuRam, _ int) map[string]any {
+		return map[string]any{
+			FieldAutoscalerNodeConstraintsCpu:    int(lo.FromPtr(item.CpuCores)),
+			FieldAutoscalerNodeConstraintsMemory: int(lo.FromPtr(item.RamMib)),
+		}
+	})
+	cpus := lo.Map(items, func(item sdk.PoliciesV1GetClusterNodeConstraintsResponseCpuRam, _ int) int32 {
+		return lo.FromPtr(item.CpuCores)
+	})
+	memory := lo.Map(items, func(item sdk.PoliciesV1GetClusterNodeConstraintsResponseCpuRam, _ int) int32 {
+		return lo.FromPtr(item.RamMib)
+	})
+
+	d.SetId(clusterID)
+	for field, value := range map[string]int32{
+		FieldAutoscalerNodeConstraintsMinCpu:    lo.Min(cpus),
+		FieldAutoscalerNodeConstraintsMaxCpu:    lo.Max(cpus),
+		FieldAutoscalerNodeConstraintsMinMemory: lo.Min(memory),
+		FieldAutoscalerNodeConstraintsMaxMemory: lo.Max(memory),
+	} {
+		if err := d.Set(field, int(value)); err != nil {
+			return diag.FromErr(fmt.Errorf("setting %s: %w", field, err))
+		}
+	}
+	if err := d.Set(FieldAutoscalerNodeConstraintsCombinations, combinations); err != nil {
+		return diag.FromErr(fmt.Errorf("setting combinations: %w", err))
+	}
+
+	return nil
+}
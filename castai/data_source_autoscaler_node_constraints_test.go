@@ -0,0 +1,49 @@
+package castai
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+	mock_sdk "github.com/castai/terraform-provider-castai/castai/sdk/mock"
+)
+
+func TestAutoscalerNodeConstraintsDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+	provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+	clusterID := "b6bfc074-a267-400f-b8f1-db0850c36aa4"
+	mockClient.EXPECT().PoliciesAPIGetClusterNodeConstraints(gomock.Any(), clusterID).
+		Return(&http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(`{
+  "clusterId": "` + clusterID + `",
+  "items": [
+    {"cpuCores": 4, "ramMib": 8192},
+    {"cpuCores": 2, "ramMib": 16384},
+    {"cpuCores": 16, "ramMib": 4096}
+  ]
+}`))), Header: map[string][]string{"Content-Type": {"json"}}}, nil)
+
+	data := schema.TestResourceDataRaw(t, dataSourceAutoscalerNodeConstraints().Schema, map[string]any{
+		FieldClusterID: clusterID,
+	})
+
+	diags := dataSourceAutoscalerNodeConstraintsRead(context.Background(), data, provider)
+	r.Nil(diags)
+	r.Equal(clusterID, data.Id())
+	r.Equal(2, data.Get(FieldAutoscalerNodeConstraintsMinCpu))
+	r.Equal(16, data.Get(FieldAutoscalerNodeConstraintsMaxCpu))
+	r.Equal(4096, data.Get(FieldAutoscalerNodeConstraintsMinMemory))
+	r.Equal(16384, data.Get(FieldAutoscalerNodeConstraintsMaxMemory))
+	r.Equal(3, data.Get(FieldAutoscalerNodeConstraintsCombinations+".#"))
+	r.Equal(8192, data.Get(FieldAutoscalerNodeConstraintsCombinations+".0."+FieldAutoscalerNodeConstraintsMemory))
+}
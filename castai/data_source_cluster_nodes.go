@@ -0,0 +1,203 @@
+package castai
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+)
+
+const (
+	FieldClusterNodesNodeTemplate  = "node_template"
+	FieldClusterNodesInstanceType  = "instance_type"
+	FieldClusterNodesLifecycleType = "lifecycle_type"
+	FieldClusterNodesZone          = "zone"
+	FieldClusterNodesLabels        = "labels"
+	FieldClusterNodesNodes         = "nodes"
+
+	FieldClusterNodeID           = "id"
+	FieldClusterNodeName         = "name"
+	FieldClusterNodeInstanceType = "instance_type"
+	FieldClusterNodePrice        = "price"
+	FieldClusterNodeState        = "state"
+	FieldClusterNodeNodeTemplate = "node_template"
+	FieldClusterNodeZone         = "zone"
+	FieldClusterNodeLabels       = "labels"
+)
+
+// nodeTemplateLabel is set by CAST AI on every node provisioned from a node template.
+const nodeTemplateLabel = "scheduling.cast.ai/node-template"
+
+func dataSourceClusterNodes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceClusterNodesRead,
+		Description: "Lists nodes of a cluster connected to CAST AI, optionally filtered by node template, instance type, " +
+			"lifecycle, zone and labels. Node `price` is deprecated: the cluster API no longer maintains it, so it is best-effort " +
+			"and may be empty or stale.",
+		Schema: map[string]*schema.Schema{
+			FieldClusterID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "CAST AI cluster id.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsUUID),
+			},
+			FieldClusterNodesNodeTemplate: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return nodes created from the node template with this name.",
+			},
+			FieldClusterNodesInstanceType: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return nodes of this instance type.",
+			},
+			FieldClusterNodesLifecycleType: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return nodes of this lifecycle type. Supported values: `spot`, `on_demand`, `fallback`.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+					string(sdk.ExternalClusterAPIListNodesParamsLifecycleTypeSpot),
+					string(sdk.ExternalClusterAPIListNodesParamsLifecycleTypeOnDemand),
+					string(sdk.ExternalClusterAPIListNodesParamsLifecycleTypeFallback),
+				}, false)),
+			},
+			FieldClusterNodesZone: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return nodes placed in this zone.",
+			},
+			FieldClusterNodesLabels: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Only return nodes which have all of these labels.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			FieldClusterNodesNodes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Nodes matching the filters.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						FieldClusterNodeID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Node ID.",
+						},
+						FieldClusterNodeName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Kubernetes node name.",
+						},
+						FieldClusterNodeInstanceType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Instance type of the node.",
+						},
+						FieldClusterNodePrice: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Price of the instance as last reported by the cluster API. Best-effort: the API no longer maintains it, as pricing moved to a separate price service, so it may be empty or stale.",
+							Deprecated:  "The cluster API no longer maintains node prices, so the value may be empty or stale.",
+						},
+						FieldClusterNodeState: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Provisioning phase of the node.",
+						},
+						FieldClusterNodeNodeTemplate: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the node template the node was created from. Empty for nodes not created from a node template.",
+						},
+						FieldClusterNodeZone: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Zone of the node.",
+						},
+						FieldClusterNodeLabels: {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "Kubernetes labels of the node.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceClusterNodesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+
+	clusterID := d.Get(FieldClusterID).(string)
+	params := &sdk.ExternalClusterAPIListNodesParams{}
+	if v, ok := d.GetOk(FieldClusterNodesNodeTemplate); ok {
+		params.NodeTemplateName = lo.ToPtr(v.(string))
+	}
+	if v, ok := d.GetOk(FieldClusterNodesInstanceType); ok {
+		params.InstanceType = lo.ToPtr(v.(string))
+	}
+	if v, ok := d.GetOk(FieldClusterNodesLifecycleType); ok {
+		params.LifecycleType = lo.ToPtr(sdk.ExternalClusterAPIListNodesParamsLifecycleType(v.(string)))
+	}
+	if v, ok := d.GetOk(FieldClusterNodesZone); ok {
+		params.Zone = lo.ToPtr(v.(string))
+	}
+	labels := toStringMap(d.Get(FieldClusterNodesLabels).(map[string]any))
+
+	var nodes []map[string]any
+	for {
+		resp, err := client.ExternalClusterAPIListNodesWithResponse(ctx, clusterID, params)
+		if err := sdk.CheckOKResponse(resp, err); err != nil {
+			return diag.FromErr(fmt.Errorf("listing cluster nodes: %w", err))
+		}
+
+		for _, node := range lo.FromPtr(resp.JSON200.Items) {
+			if !containsAllEntries(node.Labels, labels) {
+				continue
+			}
+			nodes = append(nodes, flattenClusterNode(node))
+		}
+
+		if lo.FromPtr(resp.JSON200.NextCursor) == "" {
+			break
+		}
+		params.PageCursor = resp.JSON200.NextCursor
+	}
+
+	d.SetId(clusterID)
+	if err := d.Set(FieldClusterNodesNodes, nodes); err != nil {
+		return diag.FromErr(fmt.Errorf("setting nodes: %w", err))
+	}
+
+	return nil
+}
+
+func flattenClusterNode(node sdk.ExternalclusterV1Node) map[string]any {
+	return map[string]any{
+		FieldClusterNodeID:           node.Id,
+		FieldClusterNodeName:         node.Name,
+		FieldClusterNodeInstanceType: node.InstanceType,
+		FieldClusterNodePrice:        lo.FromPtr(node.InstancePrice),
+		FieldClusterNodeState:        lo.FromPtr(node.State.Phase),
+		FieldClusterNodeNodeTemplate: node.Labels[nodeTemplateLabel],
+		FieldClusterNodeZone:         lo.FromPtr(node.Zone),
+		FieldClusterNodeLabels:       node.Labels,
+	}
+}
+
+// containsAllEntries reports whether m contains all key-value pairs of wanted.
+func containsAllEntries(m, wanted map[string]string) bool {
+	for k, v := range wanted {
+		if actual, ok := m[k]; !ok || actual != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,152 @@
+package castai
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-cty/cty"
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+	mock_sdk "github.com/castai/terraform-provider-castai/castai/sdk/mock"
+)
+
+func TestDataSourceClusterNodesRead(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+
+	ctx := context.Background()
+	provider := &ProviderConfig{
+		api: &sdk.ClientWithResponses{ClientInterface: mockClient},
+	}
+
+	clusterID := "b6bfc074-a267-400f-b8f1-db0850c36aa4"
+
+	firstPage := `{
+  "items": [
+    {
+      "id": "node-1",
+      "name": "gke-node-1",
+      "instanceType": "e2-standard-4",
+      "instancePrice": "0.134",
+      "zone": "europe-west1-b",
+      "state": {"phase": "ready"},
+      "labels": {"scheduling.cast.ai/node-template": "spot-tmpl", "team": "data"}
+    },
+    {
+      "id": "node-2",
+      "name": "gke-node-2",
+      "instanceType": "e2-standard-4",
+      "instancePrice": "0.134",
+      "zone": "europe-west1-b",
+      "state": {"phase": "ready"},
+      "labels": {"scheduling.cast.ai/node-template": "spot-tmpl", "team": "web"}
+    }
+  ],
+  "nextCursor": "page-2"
+}`
+	secondPage := `{
+  "items": [
+    {
+      "id": "node-3",
+      "name": "gke-node-3",
+      "instanceType": "e2-standard-4",
+      "instancePrice": "0.040",
+      "zone": "europe-west1-b",
+      "state": {"phase": "draining"},
+      "labels": {"scheduling.cast.ai/node-template": "spot-tmpl", "team": "data"}
+    }
+  ]
+}`
+
+	gomock.InOrder(
+		mockClient.EXPECT().
+			ExternalClusterAPIListNodes(gomock.Any(), clusterID, &sdk.ExternalClusterAPIListNodesParams{
+				NodeTemplateName: lo.ToPtr("spot-tmpl"),
+				LifecycleType:    lo.ToPtr(sdk.ExternalClusterAPIListNodesParamsLifecycleTypeSpot),
+				Zone:             lo.ToPtr("europe-west1-b"),
+			}).
+			Return(&http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(firstPage))), Header: map[string][]string{"Content-Type": {"json"}}}, nil),
+		mockClient.EXPECT().
+			ExternalClusterAPIListNodes(gomock.Any(), clusterID, &sdk.ExternalClusterAPIListNodesParams{
+				PageCursor:       lo.ToPtr("page-2"),
+				NodeTemplateName: lo.ToPtr("spot-tmpl"),
+				LifecycleType:    lo.ToPtr(sdk.ExternalClusterAPIListNodesParamsLifecycleTypeSpot),
+				Zone:             lo.ToPtr("europe-west1-b"),
+			}).
+			Return(&http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(secondPage))), Header: map[string][]string{"Content-Type": {"json"}}}, nil),
+	)
+
+	ds := dataSourceClusterNodes()
+	state := sdkterraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{
+		FieldClusterID:                 cty.StringVal(clusterID),
+		FieldClusterNodesNodeTemplate:  cty.StringVal("spot-tmpl"),
+		FieldClusterNodesLifecycleType: cty.StringVal("spot"),
+		FieldClusterNodesZone:          cty.StringVal("europe-west1-b"),
+		FieldClusterNodesLabels: cty.MapVal(map[string]cty.Value{
+			"team": cty.StringVal("data"),
+		}),
+	}), 0)
+	data := ds.Data(state)
+
+	diags := dataSourceClusterNodesRead(ctx, data, provider)
+	r.Nil(diags)
+	r.Equal(clusterID, data.Id())
+
+	nodes := data.Get(FieldClusterNodesNodes).([]any)
+	r.Len(nodes, 2)
+
+	first := nodes[0].(map[string]any)
+	r.Equal("node-1", first[FieldClusterNodeID])
+	r.Equal("gke-node-1", first[FieldClusterNodeName])
+	r.Equal("e2-standard-4", first[FieldClusterNodeInstanceType])
+	r.Equal("0.134", first[FieldClusterNodePrice])
+	r.Equal("ready", first[FieldClusterNodeState])
+	r.Equal("spot-tmpl", first[FieldClusterNodeNodeTemplate])
+	r.Equal("europe-west1-b", first[FieldClusterNodeZone])
+	r.Equal("data", first[FieldClusterNodeLabels].(map[string]any)["team"])
+
+	second := nodes[1].(map[string]any)
+	r.Equal("node-3", second[FieldClusterNodeID])
+	r.Equal("draining", second[FieldClusterNodeState])
+}
+
+func TestDataSourceClusterNodesRead_APIError(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+
+	ctx := context.Background()
+	provider := &ProviderConfig{
+		api: &sdk.ClientWithResponses{ClientInterface: mockClient},
+	}
+
+	clusterID := "b6bfc074-a267-400f-b8f1-db0850c36aa4"
+
+	mockClient.EXPECT().
+		ExternalClusterAPIListNodes(gomock.Any(), clusterID, gomock.Any()).
+		Return(&http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(bytes.NewReader([]byte(`{"message":"internal error"}`))),
+		}, nil)
+
+	ds := dataSourceClusterNodes()
+	state := sdkterraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{
+		FieldClusterID: cty.StringVal(clusterID),
+	}), 0)
+	data := ds.Data(state)
+
+	diags := dataSourceClusterNodesRead(ctx, data, provider)
+	r.True(diags.HasError())
+	r.Contains(diags[0].Summary, "listing cluster nodes")
+}
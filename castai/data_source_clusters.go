@@ -0,0 +1,195 @@
+package castai
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+)
+
+const (
+	FieldClustersProviderType = "provider_type"
+	FieldClustersStatus       = "status"
+	FieldClustersAgentStatus  = "agent_status"
+	FieldClustersNameRegex    = "name_regex"
+	FieldClustersTags         = "tags"
+	FieldClustersIDs          = "ids"
+	FieldClustersClusters     = "clusters"
+
+	FieldClustersClusterID            = "id"
+	FieldClustersClusterName          = "name"
+	FieldClustersClusterRegion        = "region"
+	FieldClustersClusterProviderType  = "provider_type"
+	FieldClustersClusterStatus        = "status"
+	FieldClustersClusterAgentStatus   = "agent_status"
+	FieldClustersClusterCredentialsID = "credentials_id"
+	FieldClustersClusterTags          = "tags"
+)
+
+func dataSourceClusters() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceClustersRead,
+		Description: "Lists clusters of the organization connected to CAST AI, optionally filtered by provider, status, " +
+			"agent status, name and tags.",
+		Schema: map[string]*schema.Schema{
+			FieldClustersProviderType: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return clusters of this provider. Supported values: `eks`, `gke`, `aks`, `anywhere`.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"eks", "gke", "aks", "anywhere"}, false)),
+			},
+			FieldClustersStatus: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return clusters with this status, e.g. `ready`, `hibernated` or `failed`.",
+			},
+			FieldClustersAgentStatus: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return clusters with this agent status, e.g. `online`, `non-responding` or `disconnected`.",
+			},
+			FieldClustersNameRegex: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only return clusters whose name matches this regular expression.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsValidRegExp),
+			},
+			FieldClustersTags: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Only return clusters which have all of these tags.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			FieldClustersIDs: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IDs of the clusters matching the filters.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			FieldClustersClusters: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Clusters matching the filters.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						FieldClustersClusterID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "CAST AI cluster id.",
+						},
+						FieldClustersClusterName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Cluster name.",
+						},
+						FieldClustersClusterRegion: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Cluster region.",
+						},
+						FieldClustersClusterProviderType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Cluster provider type.",
+						},
+						FieldClustersClusterStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Cluster status.",
+						},
+						FieldClustersClusterAgentStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the CAST AI agent running in the cluster.",
+						},
+						FieldClustersClusterCredentialsID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "CAST AI internal credentials ID.",
+						},
+						FieldClustersClusterTags: {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "Cluster tags.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceClustersRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk(FieldClustersNameRegex); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	providerType := d.Get(FieldClustersProviderType).(string)
+	status := d.Get(FieldClustersStatus).(string)
+	agentStatus := d.Get(FieldClustersAgentStatus).(string)
+	tags := toStringMap(d.Get(FieldClustersTags).(map[string]any))
+
+	resp, err := client.ExternalClusterAPIListClustersWithResponse(ctx)
+	if err := sdk.CheckOKResponse(resp, err); err != nil {
+		return diag.FromErr(fmt.Errorf("listing clusters: %w", err))
+	}
+
+	ids := make([]string, 0)
+	clusters := make([]map[string]any, 0)
+	for _, cluster := range lo.FromPtr(resp.JSON200.Items) {
+		switch {
+		case providerType != "" && lo.FromPtr(cluster.ProviderType) != providerType:
+			continue
+		case status != "" && lo.FromPtr(cluster.Status) != status:
+			continue
+		case agentStatus != "" && lo.FromPtr(cluster.AgentStatus) != agentStatus:
+			continue
+		case nameRegex != nil && !nameRegex.MatchString(lo.FromPtr(cluster.Name)):
+			continue
+		case !containsAllEntries(lo.FromPtr(cluster.Tags), tags):
+			continue
+		}
+
+		ids = append(ids, lo.FromPtr(cluster.Id))
+		clusters = append(clusters, flattenCluster(cluster))
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(ids, ","))))
+	if err := d.Set(FieldClustersIDs, ids); err != nil {
+		return diag.FromErr(fmt.Errorf("setting ids: %w", err))
+	}
+	if err := d.Set(FieldClustersClusters, clusters); err != nil {
+		return diag.FromErr(fmt.Errorf("setting clusters: %w", err))
+	}
+
+	return nil
+}
+
+func flattenCluster(cluster sdk.ExternalclusterV1Cluster) map[string]any {
+	var region string
+	if cluster.Region != nil {
+		region = lo.FromPtr(cluster.Region.Name)
+	}
+
+	return map[string]any{
+		FieldClustersClusterID:            lo.FromPtr(cluster.Id),
+		FieldClustersClusterName:          lo.FromPtr(cluster.Name),
+		FieldClustersClusterRegion:        region,
+		FieldClustersClusterProviderType:  lo.FromPtr(cluster.ProviderType),
+		FieldClustersClusterStatus:        lo.FromPtr(cluster.Status),
+		FieldClustersClusterAgentStatus:   lo.FromPtr(cluster.AgentStatus),
+		FieldClustersClusterCredentialsID: lo.FromPtr(cluster.CredentialsId),
+		FieldClustersClusterTags:          lo.FromPtr(cluster.Tags),
+	}
+}
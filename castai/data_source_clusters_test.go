@@ -0,0 +1,150 @@
+package castai
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-cty/cty"
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+	mock_sdk "github.com/castai/terraform-provider-castai/castai/sdk/mock"
+)
+
+func TestDataSourceClustersRead(t *testing.T) {
+	t.Parallel()
+
+	body := `{
+  "items": [
+    {
+      "id": "b6bfc074-a267-400f-b8f1-db0850c36aa1",
+      "name": "prod-eu",
+      "providerType": "eks",
+      "status": "ready",
+      "agentStatus": "online",
+      "credentialsId": "9b8d0456-177b-4a3e-b4b4-6d6a2a1e4c5f",
+      "region": {"name": "eu-central-1", "displayName": "Europe (Frankfurt)"},
+      "tags": {"env": "prod", "team": "platform"}
+    },
+    {
+      "id": "b6bfc074-a267-400f-b8f1-db0850c36aa2",
+      "name": "prod-us",
+      "providerType": "gke",
+      "status": "ready",
+      "agentStatus": "online",
+      "region": {"name": "us-east1"},
+      "tags": {"env": "prod"}
+    },
+    {
+      "id": "b6bfc074-a267-400f-b8f1-db0850c36aa3",
+      "name": "dev-eu",
+      "providerType": "eks",
+      "status": "hibernated",
+      "agentStatus": "disconnected",
+      "region": {"name": "eu-central-1"}
+    }
+  ]
+}`
+
+	tests := []struct {
+		name     string
+		filters  map[string]cty.Value
+		expected []string
+	}{
+		{
+			name:     "without filters returns all clusters",
+			filters:  map[string]cty.Value{},
+			expected: []string{"b6bfc074-a267-400f-b8f1-db0850c36aa1", "b6bfc074-a267-400f-b8f1-db0850c36aa2", "b6bfc074-a267-400f-b8f1-db0850c36aa3"},
+		},
+		{
+			name: "filter by provider and status",
+			filters: map[string]cty.Value{
+				FieldClustersProviderType: cty.StringVal("eks"),
+				FieldClustersStatus:       cty.StringVal("ready"),
+			},
+			expected: []string{"b6bfc074-a267-400f-b8f1-db0850c36aa1"},
+		},
+		{
+			name: "filter by agent status and name regex",
+			filters: map[string]cty.Value{
+				FieldClustersAgentStatus: cty.StringVal("online"),
+				FieldClustersNameRegex:   cty.StringVal("^prod-"),
+			},
+			expected: []string{"b6bfc074-a267-400f-b8f1-db0850c36aa1", "b6bfc074-a267-400f-b8f1-db0850c36aa2"},
+		},
+		{
+			name: "filter by tags",
+			filters: map[string]cty.Value{
+				FieldClustersTags: cty.MapVal(map[string]cty.Value{
+					"env":  cty.StringVal("prod"),
+					"team": cty.StringVal("platform"),
+				}),
+			},
+			expected: []string{"b6bfc074-a267-400f-b8f1-db0850c36aa1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+			mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+
+			ctx := context.Background()
+			provider := &ProviderConfig{
+				api: &sdk.ClientWithResponses{ClientInterface: mockClient},
+			}
+
+			mockClient.EXPECT().
+				ExternalClusterAPIListClusters(gomock.Any()).
+				Return(&http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(body))), Header: map[string][]string{"Content-Type": {"json"}}}, nil)
+
+			ds := dataSourceClusters()
+			data := ds.Data(sdkterraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(tt.filters), 0))
+
+			diags := dataSourceClustersRead(ctx, data, provider)
+			r.Nil(diags)
+			r.NotEmpty(data.Id())
+
+			ids := make([]string, 0)
+			for _, id := range data.Get(FieldClustersIDs).([]any) {
+				ids = append(ids, id.(string))
+			}
+			r.Equal(tt.expected, ids)
+			r.Len(data.Get(FieldClustersClusters).([]any), len(tt.expected))
+		})
+	}
+
+	t.Run("flattens cluster attributes", func(t *testing.T) {
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+
+		mockClient.EXPECT().
+			ExternalClusterAPIListClusters(gomock.Any()).
+			Return(&http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(body))), Header: map[string][]string{"Content-Type": {"json"}}}, nil)
+
+		ds := dataSourceClusters()
+		data := ds.Data(sdkterraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{
+			FieldClustersNameRegex: cty.StringVal("prod-eu"),
+		}), 0))
+
+		diags := dataSourceClustersRead(context.Background(), data, &ProviderConfig{
+			api: &sdk.ClientWithResponses{ClientInterface: mockClient},
+		})
+		r.Nil(diags)
+
+		cluster := data.Get(FieldClustersClusters).([]any)[0].(map[string]any)
+		r.Equal("b6bfc074-a267-400f-b8f1-db0850c36aa1", cluster[FieldClustersClusterID])
+		r.Equal("prod-eu", cluster[FieldClustersClusterName])
+		r.Equal("eu-central-1", cluster[FieldClustersClusterRegion])
+		r.Equal("eks", cluster[FieldClustersClusterProviderType])
+		r.Equal("ready", cluster[FieldClustersClusterStatus])
+		r.Equal("online", cluster[FieldClustersClusterAgentStatus])
+		r.Equal("9b8d0456-177b-4a3e-b4b4-6d6a2a1e4c5f", cluster[FieldClustersClusterCredentialsID])
+		r.Equal(map[string]any{"env": "prod", "team": "platform"}, cluster[FieldClustersClusterTags])
+	})
+}
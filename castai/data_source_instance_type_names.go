@@ -0,0 +1,110 @@
+package castai
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/samber/lo"
+)
+
+const (
+	FieldInstanceTypeNamesFamily                    = "family"
+	FieldInstanceTypeNamesInstanceTypes             = "instance_types"
+	FieldInstanceTypeNamesInstanceTypeName          = "name"
+	FieldInstanceTypeNamesInstanceTypeFamily        = "family"
+	FieldInstanceTypeNamesInstanceTypeCloudProvider = "cloud_provider"
+)
+
+func dataSourceInstanceTypeNames() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists instance type names known to CAST AI inventory. Instance types are not listed per region, " +
+			"use `castai_instance_types` to find instance types available for a particular cluster.",
+		ReadContext: dataSourceInstanceTypeNamesRead,
+		Schema: map[string]*schema.Schema{
+			FieldInventoryCloudProvider: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Return only instance types of the given cloud provider, e.g. `aws`, `gcp` or `azure`.",
+			},
+			FieldInstanceTypeNamesFamily: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Return only instance types of the given family.",
+			},
+			FieldInventoryNames: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of the matching instance types.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			FieldInstanceTypeNamesInstanceTypes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching instance types.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						FieldInstanceTypeNamesInstanceTypeName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Instance type name.",
+						},
+						FieldInstanceTypeNamesInstanceTypeFamily: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Instance type family.",
+						},
+						FieldInstanceTypeNamesInstanceTypeCloudProvider: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Cloud provider of the instance type.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceInstanceTypeNamesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+	cloudProvider := d.Get(FieldInventoryCloudProvider).(string)
+	family := d.Get(FieldInstanceTypeNamesFamily).(string)
+
+	var cloudProviders []string
+	if cloudProvider != "" {
+		cloudProviders = []string{cloudProvider}
+	}
+	instanceTypes, err := listInventoryInstanceTypeNames(ctx, client, cloudProviders)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var names []string
+	var items []map[string]any
+	for _, instanceType := range instanceTypes {
+		if family != "" && !strings.EqualFold(lo.FromPtr(instanceType.Family), family) {
+			continue
+		}
+
+		names = append(names, lo.FromPtr(instanceType.InstanceType))
+		items = append(items, map[string]any{
+			FieldInstanceTypeNamesInstanceTypeName:          lo.FromPtr(instanceType.InstanceType),
+			FieldInstanceTypeNamesInstanceTypeFamily:        lo.FromPtr(instanceType.Family),
+			FieldInstanceTypeNamesInstanceTypeCloudProvider: lo.FromPtr(instanceType.CloudServiceProvider),
+		})
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(names, ","))))
+	if err := d.Set(FieldInventoryNames, names); err != nil {
+		return diag.FromErr(fmt.Errorf("setting names: %w", err))
+	}
+	if err := d.Set(FieldInstanceTypeNamesInstanceTypes, items); err != nil {
+		return diag.FromErr(fmt.Errorf("setting instance types: %w", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package castai
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+	mock_sdk "github.com/castai/terraform-provider-castai/castai/sdk/mock"
+)
+
+func TestInstanceTypeNamesDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+	provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+	mockClient.EXPECT().
+		InventoryAPIListInstanceTypeNames(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, params *sdk.InventoryAPIListInstanceTypeNamesParams, _ ...sdk.RequestEditorFn) (*http.Response, error) {
+			r.Equal([]string{"aws"}, lo.FromPtr(params.CloudServiceProviders))
+			return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(`{
+  "items": [
+    {"instanceType": "m5.large", "family": "m5", "cloudServiceProvider": "aws"},
+    {"instanceType": "m5.xlarge", "family": "m5", "cloudServiceProvider": "aws"},
+    {"instanceType": "c5.large", "family": "c5", "cloudServiceProvider": "aws"}
+  ]
+}`))), Header: map[string][]string{"Content-Type": {"json"}}}, nil
+		})
+
+	data := schema.TestResourceDataRaw(t, dataSourceInstanceTypeNames().Schema, map[string]any{
+		FieldInventoryCloudProvider:  "aws",
+		FieldInstanceTypeNamesFamily: "m5",
+	})
+
+	diags := dataSourceInstanceTypeNamesRead(context.Background(), data, provider)
+	r.Nil(diags)
+	r.Equal([]any{"m5.large", "m5.xlarge"}, data.Get(FieldInventoryNames))
+	r.Equal("m5", data.Get(FieldInstanceTypeNamesInstanceTypes+".1."+FieldInstanceTypeNamesInstanceTypeFamily))
+}
@@ -0,0 +1,213 @@
+package castai
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+)
+
+const (
+	FieldInstanceTypes             = "instance_types"
+	FieldInstanceTypeName          = "name"
+	FieldInstanceTypeFamily        = "family"
+	FieldInstanceTypeArchitecture  = "architecture"
+	FieldInstanceTypeOs            = "os"
+	FieldInstanceTypeCpu           = "cpu"
+	FieldInstanceTypeMemory        = "memory"
+	FieldInstanceTypeCpuCost       = "cpu_cost"
+	FieldInstanceTypeBurstable     = "burstable"
+	FieldInstanceTypeBareMetal     = "bare_metal"
+	FieldInstanceTypeZones         = "zones"
+	FieldInstanceTypeGpuDevices    = "gpu_devices"
+	FieldInstanceTypeGpuCount      = "count"
+	FieldInstanceTypeGpuName       = "name"
+	FieldInstanceTypeGpuVendor     = "manufacturer"
+	FieldInstanceTypeGpuFractional = "fractional"
+)
+
+func dataSourceInstanceTypes() *schema.Resource {
+	constraints := resourceNodeTemplate().Schema[FieldNodeTemplateConstraints]
+	constraints.Description = "Constraints the instance types have to match. Same as the constraints of the `castai_node_template` resource. To find spot capable instance types set `spot` to true and `on_demand` to false."
+
+	return &schema.Resource{
+		Description: "Lists instance types available for a cluster which match node template constraints. " +
+			"On-demand and spot prices of the instance types aren't returned by the API, only `cpu_cost` is available.",
+		ReadContext: dataSourceInstanceTypesRead,
+		Schema: map[string]*schema.Schema{
+			FieldClusterID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "CAST AI cluster id.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsUUID),
+			},
+			FieldNodeTemplateConstraints: constraints,
+			FieldInstanceTypes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Instance types matching the constraints.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						FieldInstanceTypeName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Instance type name.",
+						},
+						FieldInstanceTypeFamily: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Instance type family.",
+						},
+						FieldInstanceTypeArchitecture: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "CPU architecture.",
+						},
+						FieldInstanceTypeOs: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Operating system.",
+						},
+						FieldInstanceTypeCpu: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "CPU capacity as reported by CAST AI.",
+						},
+						FieldInstanceTypeMemory: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Memory capacity as reported by CAST AI.",
+						},
+						FieldInstanceTypeCpuCost: {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Hourly price of a single CPU of the instance type, the same value the `max_price_per_cpu` constraint is compared against.",
+						},
+						FieldInstanceTypeBurstable: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the instance type is burstable.",
+						},
+						FieldInstanceTypeBareMetal: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the instance type is bare metal.",
+						},
+						FieldInstanceTypeZones: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Availability zones the instance type is available in.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						FieldInstanceTypeGpuDevices: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "GPU devices attached to the instance type.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									FieldInstanceTypeGpuName: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "GPU name.",
+									},
+									FieldInstanceTypeGpuVendor: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "GPU manufacturer.",
+									},
+									FieldInstanceTypeGpuCount: {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Number of GPUs.",
+									},
+									FieldInstanceTypeGpuFractional: {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether the GPU is fractional.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceInstanceTypesRead(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+	clusterID := data.Get(FieldClusterID).(string)
+
+	body := sdk.NodeTemplatesAPIFilterInstanceTypesJSONRequestBody{
+		Constraints: toTemplateConstraints(toSection(data, FieldNodeTemplateConstraints)),
+	}
+	// Deduplication keeps a single zone per instance type, disable it to learn all the zones.
+	params := &sdk.NodeTemplatesAPIFilterInstanceTypesParams{
+		DisableInstanceTypeDeduplication: lo.ToPtr(true),
+	}
+
+	resp, err := client.NodeTemplatesAPIFilterInstanceTypesWithResponse(ctx, clusterID, params, body)
+	if checkErr := sdk.CheckOKResponse(resp, err); checkErr != nil {
+		return apiErrorDiagnostics(fmt.Errorf("filtering instance types: %w", checkErr), dataSourceInstanceTypes().Schema)
+	}
+
+	data.SetId(clusterID)
+	if err := data.Set(FieldInstanceTypes, flattenAvailableInstanceTypes(lo.FromPtr(resp.JSON200.AvailableInstanceTypes))); err != nil {
+		return diag.FromErr(fmt.Errorf("setting instance types: %w", err))
+	}
+
+	return nil
+}
+
+// flattenAvailableInstanceTypes merges per zone entries of the same instance type into a single item listing all zones.
+func flattenAvailableInstanceTypes(items []sdk.NodetemplatesV1AvailableInstanceType) []map[string]any {
+	result := make([]map[string]any, 0, len(items))
+	byName := make(map[string]map[string]any, len(items))
+	for _, item := range items {
+		name := lo.FromPtr(item.Name)
+		zone := lo.FromPtr(item.Zone)
+
+		if existing, ok := byName[name]; ok {
+			zones := existing[FieldInstanceTypeZones].([]string)
+			if zone != "" && !lo.Contains(zones, zone) {
+				existing[FieldInstanceTypeZones] = append(zones, zone)
+			}
+			continue
+		}
+
+		zones := []string{}
+		if zone != "" {
+			zones = append(zones, zone)
+		}
+		instanceType := map[string]any{
+			FieldInstanceTypeName:         name,
+			FieldInstanceTypeFamily:       lo.FromPtr(item.Family),
+			FieldInstanceTypeArchitecture: lo.FromPtr(item.Architecture),
+			FieldInstanceTypeOs:           string(lo.FromPtr(item.Os)),
+			FieldInstanceTypeCpu:          lo.FromPtr(item.Cpu),
+			FieldInstanceTypeMemory:       lo.FromPtr(item.Memory),
+			FieldInstanceTypeCpuCost:      lo.FromPtr(item.CpuCost),
+			FieldInstanceTypeBurstable:    lo.FromPtr(item.Burstable),
+			FieldInstanceTypeBareMetal:    lo.FromPtr(item.IsBareMetal),
+			FieldInstanceTypeZones:        zones,
+			FieldInstanceTypeGpuDevices: lo.Map(lo.FromPtr(item.AvailableGpuDevices), func(gpu sdk.NodetemplatesV1AvailableInstanceTypeGPUDevice, _ int) map[string]any {
+				return map[string]any{
+					FieldInstanceTypeGpuName:       lo.FromPtr(gpu.Name),
+					FieldInstanceTypeGpuVendor:     lo.FromPtr(gpu.Manufacturer),
+					FieldInstanceTypeGpuCount:      int(lo.FromPtr(gpu.Count)),
+					FieldInstanceTypeGpuFractional: lo.FromPtr(gpu.Fractional),
+				}
+			}),
+		}
+		byName[name] = instanceType
+		result = append(result, instanceType)
+	}
+
+	return result
+}
@@ -0,0 +1,75 @@
+package castai
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+	mock_sdk "github.com/castai/terraform-provider-castai/castai/sdk/mock"
+)
+
+func TestInstanceTypesDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+
+	ctx := context.Background()
+	provider := &ProviderConfig{
+		api: &sdk.ClientWithResponses{
+			ClientInterface: mockClient,
+		},
+	}
+
+	clusterID := "b6bfc074-a267-400f-b8f1-db0850c36aa4"
+
+	mockClient.EXPECT().
+		NodeTemplatesAPIFilterInstanceTypes(gomock.Any(), clusterID, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, params *sdk.NodeTemplatesAPIFilterInstanceTypesParams, body sdk.NodeTemplatesAPIFilterInstanceTypesJSONRequestBody, _ ...sdk.RequestEditorFn) (*http.Response, error) {
+			r.True(lo.FromPtr(params.DisableInstanceTypeDeduplication))
+			r.True(lo.FromPtr(body.Constraints.Spot))
+			r.False(lo.FromPtr(body.Constraints.OnDemand))
+			r.Equal(int32(4), lo.FromPtr(body.Constraints.MinCpu))
+
+			return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(`{
+  "availableInstanceTypes": [
+    {"name": "m5.xlarge", "family": "m5", "architecture": "amd64", "os": "linux", "cpu": "4", "memory": "16384", "cpuCost": 0.012, "zone": "us-east-1a"},
+    {"name": "m5.xlarge", "family": "m5", "architecture": "amd64", "os": "linux", "cpu": "4", "memory": "16384", "cpuCost": 0.012, "zone": "us-east-1b"},
+    {"name": "g4dn.xlarge", "family": "g4dn", "architecture": "amd64", "os": "linux", "cpu": "4", "memory": "16384", "cpuCost": 0.05, "zone": "us-east-1a",
+     "availableGpuDevices": [{"name": "t4", "manufacturer": "NVIDIA", "count": 1}]}
+  ]
+}`))), Header: map[string][]string{"Content-Type": {"json"}}}, nil
+		})
+
+	ds := dataSourceInstanceTypes()
+	data := schema.TestResourceDataRaw(t, ds.Schema, map[string]any{
+		FieldClusterID: clusterID,
+		FieldNodeTemplateConstraints: []any{
+			map[string]any{
+				FieldNodeTemplateSpot:     true,
+				FieldNodeTemplateOnDemand: false,
+				FieldNodeTemplateMinCpu:   4,
+			},
+		},
+	})
+
+	diags := dataSourceInstanceTypesRead(ctx, data, provider)
+	r.Nil(diags)
+	r.Equal(clusterID, data.Id())
+	r.Equal(2, data.Get(FieldInstanceTypes+".#"))
+	r.Equal("m5.xlarge", data.Get(FieldInstanceTypes+".0.name"))
+	r.Equal([]any{"us-east-1a", "us-east-1b"}, data.Get(FieldInstanceTypes+".0.zones"))
+	r.Equal(0.012, data.Get(FieldInstanceTypes+".0.cpu_cost"))
+	r.Equal("g4dn.xlarge", data.Get(FieldInstanceTypes+".1.name"))
+	r.Equal([]any{"us-east-1a"}, data.Get(FieldInstanceTypes+".1.zones"))
+	r.Equal("t4", data.Get(FieldInstanceTypes+".1.gpu_devices.0.name"))
+	r.Equal(1, data.Get(FieldInstanceTypes+".1.gpu_devices.0.count"))
+}
@@ -0,0 +1,157 @@
+package castai
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+)
+
+const (
+	FieldNodeConfigurationSuggestionInstanceProfileArns = "instance_profile_arns"
+	FieldNodeConfigurationSuggestionSecurityGroupIDs    = "security_group_ids"
+	FieldNodeConfigurationSuggestionMaxPodsPresets      = "max_pods_per_node_formula_presets"
+)
+
+func dataSourceNodeConfigurationSuggestion() *schema.Resource {
+	dataSourceNodeConfigurationSuggestion := &schema.Resource{
+		Description: "Suggests node configuration settings for a cluster, such as subnets, security groups and instance profile, based on the cluster's cloud resources.",
+		ReadContext: dataSourceNodeConfigurationSuggestionRead,
+		Schema: map[string]*schema.Schema{
+			FieldClusterID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "CAST AI cluster id.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsUUID),
+			},
+			FieldNodeConfigurationSuggestionInstanceProfileArns: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "ARNs of all instance profiles available in the AWS account. The first one is suggested in the `eks` block.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			FieldNodeConfigurationSuggestionSecurityGroupIDs: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IDs of all security groups in the cluster VPC.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			FieldNodeConfigurationSuggestionMaxPodsPresets: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Preset formulas which can be used as `max_pods_per_node_formula`.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+
+	// Suggested settings have exactly the same shape as on the node configuration resource.
+	resourceNodeConfiguration := resourceNodeConfiguration()
+	for _, key := range []string{
+		FieldNodeConfigurationSubnets,
+		FieldNodeConfigurationEKS,
+		FieldNodeConfigurationGKE,
+		FieldNodeConfigurationAKS,
+	} {
+		value := resourceNodeConfiguration.Schema[key]
+		value.Computed = true
+		value.Optional = false
+		value.Required = false
+		value.ValidateDiagFunc = nil
+		//  MaxItems is for configurable attributes, there's nothing to configure on computed-only field
+		value.MaxItems = 0
+		if elem, ok := value.Elem.(*schema.Resource); ok {
+			// Conflicts can't be validated on computed-only blocks without MaxItems.
+			for _, nested := range elem.Schema {
+				nested.ConflictsWith = nil
+			}
+		}
+		dataSourceNodeConfigurationSuggestion.Schema[key] = value
+	}
+
+	return dataSourceNodeConfigurationSuggestion
+}
+
+func dataSourceNodeConfigurationSuggestionRead(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+	clusterID := data.Get(FieldClusterID).(string)
+
+	cluster, err := fetchClusterData(ctx, client, clusterID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("getting cluster: %w", err))
+	}
+	if cluster == nil {
+		return diag.Errorf("cluster %s not found", clusterID)
+	}
+
+	suggestion, err := client.NodeConfigurationAPIGetSuggestedConfigurationWithResponse(ctx, clusterID)
+	if checkErr := sdk.CheckOKResponse(suggestion, err); checkErr != nil {
+		return diag.FromErr(fmt.Errorf("getting suggested node configuration: %w", checkErr))
+	}
+
+	presets, err := client.NodeConfigurationAPIListMaxPodsPresetsWithResponse(ctx)
+	if checkErr := sdk.CheckOKResponse(presets, err); checkErr != nil {
+		return diag.FromErr(fmt.Errorf("listing max pods presets: %w", checkErr))
+	}
+
+	subnets := lo.Map(lo.FromPtr(suggestion.JSON200.Subnets), func(s sdk.NodeconfigV1SubnetDetails, _ int) string {
+		return lo.FromPtr(s.Id)
+	})
+	instanceProfiles := lo.Map(lo.FromPtr(suggestion.JSON200.InstanceProfiles), func(p sdk.NodeconfigV1InstanceProfile, _ int) string {
+		return lo.FromPtr(p.Arn)
+	})
+	securityGroups := lo.Map(lo.FromPtr(suggestion.JSON200.SecurityGroups), func(g sdk.NodeconfigV1SecurityGroup, _ int) string {
+		return lo.FromPtr(g.Id)
+	})
+
+	var eks, gke, aks []map[string]any
+	switch {
+	case cluster.JSON200.Eks != nil:
+		eksConfig := &sdk.NodeconfigV1EKSConfig{}
+		if len(instanceProfiles) > 0 {
+			eksConfig.InstanceProfileArn = instanceProfiles[0]
+		}
+		if len(securityGroups) > 0 {
+			eksConfig.SecurityGroups = lo.ToPtr(securityGroups)
+		}
+		eks = flattenEKSConfig(eksConfig)
+	case cluster.JSON200.Gke != nil:
+		gke = flattenGKEConfig(&sdk.NodeconfigV1GKEConfig{
+			MaxPodsPerNode: cluster.JSON200.Gke.MaxPodsPerNode,
+		})
+	case cluster.JSON200.Aks != nil:
+		aks = flattenAKSConfig(&sdk.NodeconfigV1AKSConfig{
+			MaxPodsPerNode: cluster.JSON200.Aks.MaxPodsPerNode,
+		})
+	}
+
+	data.SetId(clusterID)
+	if err := data.Set(FieldNodeConfigurationSubnets, subnets); err != nil {
+		return diag.FromErr(fmt.Errorf("setting subnets: %w", err))
+	}
+	if err := data.Set(FieldNodeConfigurationSuggestionInstanceProfileArns, instanceProfiles); err != nil {
+		return diag.FromErr(fmt.Errorf("setting instance profile arns: %w", err))
+	}
+	if err := data.Set(FieldNodeConfigurationSuggestionSecurityGroupIDs, securityGroups); err != nil {
+		return diag.FromErr(fmt.Errorf("setting security group ids: %w", err))
+	}
+	if err := data.Set(FieldNodeConfigurationSuggestionMaxPodsPresets, lo.FromPtr(presets.JSON200.Presets)); err != nil {
+		return diag.FromErr(fmt.Errorf("setting max pods presets: %w", err))
+	}
+	if err := data.Set(FieldNodeConfigurationEKS, eks); err != nil {
+		return diag.FromErr(fmt.Errorf("setting eks: %w", err))
+	}
+	if err := data.Set(FieldNodeConfigurationGKE, gke); err != nil {
+		return diag.FromErr(fmt.Errorf("setting gke: %w", err))
+	}
+	if err := data.Set(FieldNodeConfigurationAKS, aks); err != nil {
+		return diag.FromErr(fmt.Errorf("setting aks: %w", err))
+	}
+
+	return nil
+}
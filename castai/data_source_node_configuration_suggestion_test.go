@@ -0,0 +1,80 @@
+package castai
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+	mock_sdk "github.com/castai/terraform-provider-castai/castai/sdk/mock"
+)
+
+func TestNodeConfigurationSuggestionDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	clusterID := "b6bfc074-a267-400f-b8f1-db0850c36aa4"
+	newResponse := func(body string) *http.Response {
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(body))), Header: map[string][]string{"Content-Type": {"json"}}}
+	}
+	suggestionBody := `{
+  "subnets": [{"id": "subnet-1", "zone": {"name": "us-east-1a"}}, {"id": "subnet-2", "zone": {"name": "us-east-1b"}}],
+  "securityGroups": [{"id": "sg-1"}, {"id": "sg-2"}],
+  "instanceProfiles": [{"arn": "arn:aws:iam::123456789012:instance-profile/cast-eks", "name": "cast-eks"}]
+}`
+	presetsBody := `{"presets": ["math.least(110, NUM_CPU * 10)"]}`
+
+	t.Run("eks cluster", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		mockClient.EXPECT().ExternalClusterAPIGetCluster(gomock.Any(), clusterID).
+			Return(newResponse(`{"id": "`+clusterID+`", "status": "ready", "eks": {"clusterName": "eks"}}`), nil)
+		mockClient.EXPECT().NodeConfigurationAPIGetSuggestedConfiguration(gomock.Any(), clusterID).Return(newResponse(suggestionBody), nil)
+		mockClient.EXPECT().NodeConfigurationAPIListMaxPodsPresets(gomock.Any()).Return(newResponse(presetsBody), nil)
+
+		data := schema.TestResourceDataRaw(t, dataSourceNodeConfigurationSuggestion().Schema, map[string]any{
+			FieldClusterID: clusterID,
+		})
+
+		diags := dataSourceNodeConfigurationSuggestionRead(context.Background(), data, provider)
+		r.Nil(diags)
+		r.Equal(clusterID, data.Id())
+		r.Equal([]any{"subnet-1", "subnet-2"}, data.Get(FieldNodeConfigurationSubnets))
+		r.Equal([]any{"sg-1", "sg-2"}, data.Get(FieldNodeConfigurationSuggestionSecurityGroupIDs))
+		r.Equal([]any{"math.least(110, NUM_CPU * 10)"}, data.Get(FieldNodeConfigurationSuggestionMaxPodsPresets))
+		r.Equal("arn:aws:iam::123456789012:instance-profile/cast-eks", data.Get(FieldNodeConfigurationEKS+".0.instance_profile_arn"))
+		r.Equal([]any{"sg-1", "sg-2"}, data.Get(FieldNodeConfigurationEKS+".0.security_groups"))
+		r.Equal(0, data.Get(FieldNodeConfigurationGKE+".#"))
+		r.Equal(0, data.Get(FieldNodeConfigurationAKS+".#"))
+	})
+
+	t.Run("gke cluster", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		mockClient.EXPECT().ExternalClusterAPIGetCluster(gomock.Any(), clusterID).
+			Return(newResponse(`{"id": "`+clusterID+`", "status": "ready", "gke": {"clusterName": "gke", "maxPodsPerNode": 64}}`), nil)
+		mockClient.EXPECT().NodeConfigurationAPIGetSuggestedConfiguration(gomock.Any(), clusterID).Return(newResponse(`{"subnets": [{"id": "default"}]}`), nil)
+		mockClient.EXPECT().NodeConfigurationAPIListMaxPodsPresets(gomock.Any()).Return(newResponse(presetsBody), nil)
+
+		data := schema.TestResourceDataRaw(t, dataSourceNodeConfigurationSuggestion().Schema, map[string]any{
+			FieldClusterID: clusterID,
+		})
+
+		diags := dataSourceNodeConfigurationSuggestionRead(context.Background(), data, provider)
+		r.Nil(diags)
+		r.Equal([]any{"default"}, data.Get(FieldNodeConfigurationSubnets))
+		r.Equal(64, data.Get(FieldNodeConfigurationGKE+".0.max_pods_per_node"))
+		r.Equal(0, data.Get(FieldNodeConfigurationEKS+".#"))
+	})
+}
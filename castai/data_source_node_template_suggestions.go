@@ -0,0 +1,133 @@
+package castai
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+)
+
+const (
+	FieldNodeTemplateSuggestions = "node_templates"
+)
+
+// nodeTemplateSuggestionFields are the node template attributes returned for every suggestion.
+var nodeTemplateSuggestionFields = []string{
+	FieldNodeTemplateName,
+	FieldNodeTemplateIsEnabled,
+	FieldNodeTemplateConfigurationId,
+	FieldNodeTemplateShouldTaint,
+	FieldNodeTemplateConstraints,
+	FieldNodeTemplateCustomLabels,
+	FieldNodeTemplateCustomTaints,
+	FieldNodeTemplateRebalancingConfigMinNodes,
+	FieldNodeTemplateCustomInstancesEnabled,
+	FieldNodeTemplateCustomInstancesWithExtendedMemoryEnabled,
+	FieldNodeTemplateGpu,
+}
+
+func dataSourceNodeTemplateSuggestions() *schema.Resource {
+	// Suggestions are shaped exactly like the node template resource, so they can be passed to it as is.
+	nodeTemplate := resourceNodeTemplate()
+	suggestion := make(map[string]*schema.Schema, len(nodeTemplateSuggestionFields))
+	for _, key := range nodeTemplateSuggestionFields {
+		value := nodeTemplate.Schema[key]
+		value.Computed = true
+		value.Optional = false
+		value.Required = false
+		value.ForceNew = false
+		value.Default = nil
+		value.ValidateDiagFunc = nil
+		value.DiffSuppressFunc = nil
+		//  MaxItems is for configurable attributes, there's nothing to configure on computed-only field
+		value.MaxItems = 0
+		suggestion[key] = value
+	}
+
+	return &schema.Resource{
+		Description: "Retrieves node templates CAST AI suggests for a cluster based on its current workloads. " +
+			"The API doesn't report which workloads a suggestion was generated for.",
+		ReadContext: dataSourceNodeTemplateSuggestionsRead,
+		Schema: map[string]*schema.Schema{
+			FieldClusterID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "CAST AI cluster id.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsUUID),
+			},
+			FieldNodeTemplateSuggestions: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Suggested node templates, with the same attributes as the `castai_node_template` resource.",
+				Elem: &schema.Resource{
+					Schema: suggestion,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNodeTemplateSuggestionsRead(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+	clusterID := data.Get(FieldClusterID).(string)
+
+	resp, err := client.NodeTemplatesAPIGenerateNodeTemplatesWithResponse(ctx, clusterID)
+	if checkErr := sdk.CheckOKResponse(resp, err); checkErr != nil {
+		return diag.FromErr(fmt.Errorf("generating node templates: %w", checkErr))
+	}
+
+	suggestions := make([]map[string]any, 0, len(lo.FromPtr(resp.JSON200.Items)))
+	for _, item := range lo.FromPtr(resp.JSON200.Items) {
+		suggestion, err := flattenNodeTemplateSuggestion(item)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("flattening node template %q: %w", lo.FromPtr(item.Template.Name), err))
+		}
+		suggestions = append(suggestions, suggestion)
+	}
+
+	data.SetId(clusterID)
+	if err := data.Set(FieldNodeTemplateSuggestions, suggestions); err != nil {
+		return diag.FromErr(fmt.Errorf("setting node templates: %w", err))
+	}
+
+	return nil
+}
+
+func flattenNodeTemplateSuggestion(item sdk.NodetemplatesV1NodeTemplateListItem) (map[string]any, error) {
+	template := item.Template
+	out := map[string]any{
+		FieldNodeTemplateName:                                     lo.FromPtr(template.Name),
+		FieldNodeTemplateIsEnabled:                                lo.FromPtr(template.IsEnabled),
+		FieldNodeTemplateConfigurationId:                          lo.FromPtr(template.ConfigurationId),
+		FieldNodeTemplateShouldTaint:                              lo.FromPtr(template.ShouldTaint),
+		FieldNodeTemplateCustomLabels:                             lo.FromPtr(template.CustomLabels),
+		FieldNodeTemplateCustomTaints:                             flattenCustomTaints(template.CustomTaints),
+		FieldNodeTemplateCustomInstancesEnabled:                   lo.FromPtr(template.CustomInstancesEnabled),
+		FieldNodeTemplateCustomInstancesWithExtendedMemoryEnabled: lo.FromPtr(template.CustomInstancesWithExtendedMemoryEnabled),
+	}
+
+	if template.RebalancingConfig != nil {
+		out[FieldNodeTemplateRebalancingConfigMinNodes] = int(lo.FromPtr(template.RebalancingConfig.MinNodes))
+	}
+	if template.Constraints != nil {
+		constraints, err := flattenConstraints(template.Constraints)
+		if err != nil {
+			return nil, fmt.Errorf("flattening constraints: %w", err)
+		}
+		out[FieldNodeTemplateConstraints] = constraints
+	}
+	if template.Gpu != nil {
+		gpu, err := flattenGpuSettings(template.Gpu)
+		if err != nil {
+			return nil, fmt.Errorf("flattening gpu settings: %w", err)
+		}
+		out[FieldNodeTemplateGpu] = gpu
+	}
+
+	return out, nil
+}
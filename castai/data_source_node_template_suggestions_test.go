@@ -0,0 +1,79 @@
+package castai
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+	mock_sdk "github.com/castai/terraform-provider-castai/castai/sdk/mock"
+)
+
+func TestNodeTemplateSuggestionsDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+
+	ctx := context.Background()
+	provider := &ProviderConfig{
+		api: &sdk.ClientWithResponses{
+			ClientInterface: mockClient,
+		},
+	}
+
+	clusterID := "b6bfc074-a267-400f-b8f1-db0850c36aa4"
+	body := io.NopCloser(bytes.NewReader([]byte(`{
+  "items": [
+    {
+      "template": {
+        "name": "spot-arm",
+        "isEnabled": true,
+        "shouldTaint": true,
+        "customLabels": {"team": "data"},
+        "customTaints": [{"key": "dedicated", "value": "data", "effect": "NoSchedule"}],
+        "customInstancesEnabled": true,
+        "constraints": {
+          "spot": true,
+          "onDemand": false,
+          "minCpu": 2,
+          "maxCpu": 16,
+          "architectures": ["arm64"]
+        }
+      },
+      "stats": {"countSpot": 3, "countOnDemand": 0, "countFallback": 1, "countSoleTenant": 0}
+    }
+  ]
+}`)))
+
+	mockClient.EXPECT().
+		NodeTemplatesAPIGenerateNodeTemplates(gomock.Any(), clusterID).
+		Return(&http.Response{StatusCode: 200, Body: body, Header: map[string][]string{"Content-Type": {"json"}}}, nil)
+
+	ds := dataSourceNodeTemplateSuggestions()
+	data := schema.TestResourceDataRaw(t, ds.Schema, map[string]any{
+		FieldClusterID: clusterID,
+	})
+
+	diags := dataSourceNodeTemplateSuggestionsRead(ctx, data, provider)
+	r.Nil(diags)
+	r.Equal(clusterID, data.Id())
+	r.Equal(1, data.Get(FieldNodeTemplateSuggestions+".#"))
+
+	prefix := FieldNodeTemplateSuggestions + ".0."
+	r.Equal("spot-arm", data.Get(prefix+FieldNodeTemplateName))
+	r.Equal(true, data.Get(prefix+FieldNodeTemplateShouldTaint))
+	r.Equal(true, data.Get(prefix+FieldNodeTemplateCustomInstancesEnabled))
+	r.Equal(map[string]any{"team": "data"}, data.Get(prefix+FieldNodeTemplateCustomLabels))
+	r.Equal("dedicated", data.Get(prefix+FieldNodeTemplateCustomTaints+".0.key"))
+	r.Equal("NoSchedule", data.Get(prefix+FieldNodeTemplateCustomTaints+".0.effect"))
+	r.Equal(true, data.Get(prefix+FieldNodeTemplateConstraints+".0.spot"))
+	r.Equal(16, data.Get(prefix+FieldNodeTemplateConstraints+".0.max_cpu"))
+	r.Equal([]any{"arm64"}, data.Get(prefix+FieldNodeTemplateConstraints+".0.architectures"))
+}
@@ -0,0 +1,96 @@
+package castai
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+)
+
+const (
+	FieldRebalancingSchedulePreviewAffectedNodeIDs = "affected_node_ids"
+	FieldRebalancingSchedulePreviewWillTriggerAt   = "will_trigger_at"
+)
+
+func dataSourceRebalancingSchedulePreview() *schema.Resource {
+	dataSourceRebalancingSchedulePreview := &schema.Resource{
+		Description: "Previews which nodes a rebalancing schedule would target in a cluster, without creating the schedule. " +
+			"The preview API doesn't estimate savings, so neither the expected savings nor whether `trigger_conditions` are currently met is reported.",
+		ReadContext: dataSourceRebalancingSchedulePreviewRead,
+		Schema: map[string]*schema.Schema{
+			FieldClusterID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "CAST AI cluster id.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsUUID),
+			},
+			FieldRebalancingSchedulePreviewAffectedNodeIDs: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IDs of the nodes which would be targeted by the schedule.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			FieldRebalancingSchedulePreviewWillTriggerAt: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Times in RFC3339 format when the schedule would trigger.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+
+	// Schedule settings are configured exactly like on the rebalancing schedule resource.
+	resourceRebalancingSchedule := resourceRebalancingSchedule()
+	for key, value := range resourceRebalancingSchedule.Schema {
+		dataSourceRebalancingSchedulePreview.Schema[key] = value
+		if key == "name" {
+			value.Required = false
+			value.Optional = true
+			value.Description = "Name of the schedule."
+		}
+	}
+	return dataSourceRebalancingSchedulePreview
+}
+
+func dataSourceRebalancingSchedulePreviewRead(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+	clusterID := data.Get(FieldClusterID).(string)
+
+	schedule, err := stateToSchedule(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.ScheduledRebalancingAPIPreviewRebalancingScheduleWithResponse(ctx, clusterID, sdk.ScheduledRebalancingAPIPreviewRebalancingScheduleJSONRequestBody{
+		Name:                lo.ToPtr(schedule.Name),
+		Schedule:            &schedule.Schedule,
+		LaunchConfiguration: &schedule.LaunchConfiguration,
+		TriggerConditions:   &schedule.TriggerConditions,
+	})
+	if checkErr := sdk.CheckOKResponse(resp, err); checkErr != nil {
+		return apiErrorDiagnostics(fmt.Errorf("previewing rebalancing schedule: %w", checkErr), dataSourceRebalancingSchedulePreview().Schema)
+	}
+
+	nodeIDs := lo.Map(lo.FromPtr(resp.JSON200.AffectedNodes), func(node sdk.ScheduledrebalancingV1Node, _ int) string {
+		return lo.FromPtr(node.Id)
+	})
+	triggerTimes := lo.Map(lo.FromPtr(resp.JSON200.WillTriggerAt), func(t time.Time, _ int) string {
+		return t.Format(time.RFC3339)
+	})
+
+	data.SetId(clusterID)
+	if err := data.Set(FieldRebalancingSchedulePreviewAffectedNodeIDs, nodeIDs); err != nil {
+		return diag.FromErr(fmt.Errorf("setting affected node ids: %w", err))
+	}
+	if err := data.Set(FieldRebalancingSchedulePreviewWillTriggerAt, triggerTimes); err != nil {
+		return diag.FromErr(fmt.Errorf("setting will trigger at: %w", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package castai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+	mock_sdk "github.com/castai/terraform-provider-castai/castai/sdk/mock"
+)
+
+func TestRebalancingSchedulePreviewDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+
+	ctx := context.Background()
+	provider := &ProviderConfig{
+		api: &sdk.ClientWithResponses{
+			ClientInterface: mockClient,
+		},
+	}
+
+	clusterID := "b6bfc074-a267-400f-b8f1-db0850c36aa4"
+
+	mockClient.EXPECT().
+		ScheduledRebalancingAPIPreviewRebalancingSchedule(gomock.Any(), clusterID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, body sdk.ScheduledRebalancingAPIPreviewRebalancingScheduleJSONRequestBody, _ ...sdk.RequestEditorFn) (*http.Response, error) {
+			r.Equal("0 3 * * *", body.Schedule.Cron)
+			r.Equal(float32(15), lo.FromPtr(body.TriggerConditions.SavingsPercentage))
+			r.Equal(int32(5), lo.FromPtr(body.LaunchConfiguration.NumTargetedNodes))
+			selector, err := json.Marshal(body.LaunchConfiguration.Selector)
+			r.NoError(err)
+			r.JSONEq(`{"nodeSelectorTerms":[{"matchExpressions":[{"key":"scheduling.cast.ai/spot","operator":"Exists"}]}]}`, string(selector))
+
+			return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(`{
+  "affectedNodes": [{"id": "node-1"}, {"id": "node-2"}],
+  "willTriggerAt": ["2026-10-18T03:00:00Z", "2026-10-19T03:00:00Z"]
+}`))), Header: map[string][]string{"Content-Type": {"json"}}}, nil
+		})
+
+	ds := dataSourceRebalancingSchedulePreview()
+	data := schema.TestResourceDataRaw(t, ds.Schema, map[string]any{
+		FieldClusterID: clusterID,
+		"schedule": []any{
+			map[string]any{"cron": "0 3 * * *"},
+		},
+		"trigger_conditions": []any{
+			map[string]any{"savings_percentage": 15.0},
+		},
+		"launch_configuration": []any{
+			map[string]any{
+				"num_targeted_nodes": 5,
+				"selector":           `{"nodeSelectorTerms":[{"matchExpressions":[{"key":"scheduling.cast.ai/spot","operator":"Exists"}]}]}`,
+			},
+		},
+	})
+
+	diags := dataSourceRebalancingSchedulePreviewRead(ctx, data, provider)
+	r.Nil(diags)
+	r.Equal(clusterID, data.Id())
+	r.Equal([]any{"node-1", "node-2"}, data.Get(FieldRebalancingSchedulePreviewAffectedNodeIDs))
+	r.Equal([]any{"2026-10-18T03:00:00Z", "2026-10-19T03:00:00Z"}, data.Get(FieldRebalancingSchedulePreviewWillTriggerAt))
+}
@@ -0,0 +1,140 @@
+package castai
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/samber/lo"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+)
+
+const (
+	FieldInventoryCloudProvider     = "cloud_provider"
+	FieldInventoryNames             = "names"
+	FieldRegionsIncludeUnavailable  = "include_unavailable"
+	FieldRegionsRegions             = "regions"
+	FieldRegionsRegionID            = "id"
+	FieldRegionsRegionName          = "name"
+	FieldRegionsRegionDisplayName   = "display_name"
+	FieldRegionsRegionCategory      = "category"
+	FieldRegionsRegionAvailable     = "available"
+	FieldRegionsRegionZones         = "zones"
+	FieldRegionsRegionCloudProvider = "cloud_provider"
+)
+
+func dataSourceRegions() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists regions known to CAST AI inventory.",
+		ReadContext: dataSourceRegionsRead,
+		Schema: map[string]*schema.Schema{
+			FieldInventoryCloudProvider: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Return only regions of the given cloud provider, e.g. `aws`, `gcp` or `azure`.",
+			},
+			FieldRegionsIncludeUnavailable: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Include regions which are no longer available.",
+			},
+			FieldInventoryNames: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of the matching regions.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			FieldRegionsRegions: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching regions.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						FieldRegionsRegionID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Region ID.",
+						},
+						FieldRegionsRegionName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Region name.",
+						},
+						FieldRegionsRegionDisplayName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Human readable region name.",
+						},
+						FieldRegionsRegionCloudProvider: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Cloud provider of the region.",
+						},
+						FieldRegionsRegionCategory: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Region category.",
+						},
+						FieldRegionsRegionAvailable: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the region is still available.",
+						},
+						FieldRegionsRegionZones: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Names of the zones in the region.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRegionsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+	cloudProvider := d.Get(FieldInventoryCloudProvider).(string)
+	includeUnavailable := d.Get(FieldRegionsIncludeUnavailable).(bool)
+
+	regions, err := listInventoryRegions(ctx, client, includeUnavailable)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var names []string
+	var items []map[string]any
+	for _, region := range regions {
+		if cloudProvider != "" && !strings.EqualFold(lo.FromPtr(region.Csp), cloudProvider) {
+			continue
+		}
+
+		names = append(names, lo.FromPtr(region.Name))
+		items = append(items, map[string]any{
+			FieldRegionsRegionID:            lo.FromPtr(region.Id),
+			FieldRegionsRegionName:          lo.FromPtr(region.Name),
+			FieldRegionsRegionDisplayName:   lo.FromPtr(region.DisplayName),
+			FieldRegionsRegionCloudProvider: lo.FromPtr(region.Csp),
+			FieldRegionsRegionCategory:      lo.FromPtr(region.Category),
+			FieldRegionsRegionAvailable:     region.UnavailabilityTime == nil,
+			FieldRegionsRegionZones: lo.Map(lo.FromPtr(region.Zones), func(zone sdk.CastaiInventoryV1beta1RegionZone, _ int) string {
+				return lo.FromPtr(zone.Name)
+			}),
+		})
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(names, ","))))
+	if err := d.Set(FieldInventoryNames, names); err != nil {
+		return diag.FromErr(fmt.Errorf("setting names: %w", err))
+	}
+	if err := d.Set(FieldRegionsRegions, items); err != nil {
+		return diag.FromErr(fmt.Errorf("setting regions: %w", err))
+	}
+
+	return nil
+}
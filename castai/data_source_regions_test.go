@@ -0,0 +1,63 @@
+package castai
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+	mock_sdk "github.com/castai/terraform-provider-castai/castai/sdk/mock"
+)
+
+func TestRegionsDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+	provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+	newResponse := func(body string) *http.Response {
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(body))), Header: map[string][]string{"Content-Type": {"json"}}}
+	}
+
+	gomock.InOrder(
+		mockClient.EXPECT().
+			InventoryAPIListRegions(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, params *sdk.InventoryAPIListRegionsParams, _ ...sdk.RequestEditorFn) (*http.Response, error) {
+				r.True(lo.FromPtr(params.IncludeUnavailable))
+				r.Nil(params.PageToken)
+				return newResponse(`{
+  "regions": [
+    {"id": "r1", "name": "us-east-1", "displayName": "US East (N. Virginia)", "csp": "aws", "zones": [{"name": "us-east-1a"}, {"name": "us-east-1b"}]},
+    {"id": "r2", "name": "europe-west1", "csp": "gcp"}
+  ],
+  "nextPageToken": "next"
+}`), nil
+			}),
+		mockClient.EXPECT().
+			InventoryAPIListRegions(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, params *sdk.InventoryAPIListRegionsParams, _ ...sdk.RequestEditorFn) (*http.Response, error) {
+				r.Equal("next", lo.FromPtr(params.PageToken))
+				return newResponse(`{"regions": [{"id": "r3", "name": "us-west-2", "csp": "AWS", "unavailabilityTime": "2026-01-01T00:00:00Z"}]}`), nil
+			}),
+	)
+
+	data := schema.TestResourceDataRaw(t, dataSourceRegions().Schema, map[string]any{
+		FieldInventoryCloudProvider:    "aws",
+		FieldRegionsIncludeUnavailable: true,
+	})
+
+	diags := dataSourceRegionsRead(context.Background(), data, provider)
+	r.Nil(diags)
+	r.Equal([]any{"us-east-1", "us-west-2"}, data.Get(FieldInventoryNames))
+	r.Equal("US East (N. Virginia)", data.Get(FieldRegionsRegions+".0."+FieldRegionsRegionDisplayName))
+	r.Equal([]any{"us-east-1a", "us-east-1b"}, data.Get(FieldRegionsRegions+".0."+FieldRegionsRegionZones))
+	r.Equal(true, data.Get(FieldRegionsRegions+".0."+FieldRegionsRegionAvailable))
+	r.Equal(false, data.Get(FieldRegionsRegions+".1."+FieldRegionsRegionAvailable))
+}
@@ -17,6 +17,10 @@ func dataSourceHibernationSchedule() *schema.Resource {
 
 	resourceHibernationSchedule := resourceHibernationSchedule()
 	for key, value := range resourceHibernationSchedule.Schema {
+		if key == FieldValidateInventory {
+			// plan-time validation is meaningless when reading an existing schedule
+			continue
+		}
 		dataSourceHibernationSchedule.Schema[key] = value
 		if key != FieldHibernationScheduleName && key != FieldHibernationScheduleOrganizationID {
 			// only name and optionally organization id are provided in terraform configuration by user
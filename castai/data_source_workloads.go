@@ -0,0 +1,310 @@
+package castai
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+)
+
+const (
+	FieldWorkloadsNamespaces                  = "namespaces"
+	FieldWorkloadsKinds                       = "kinds"
+	FieldWorkloadsScalingPolicyNames          = "scaling_policy_names"
+	FieldWorkloadsLabels                      = "labels"
+	FieldWorkloadsWorkloads                   = "workloads"
+	FieldWorkloadsAvailableNamespaces         = "available_namespaces"
+	FieldWorkloadsAvailableKinds              = "available_kinds"
+	FieldWorkloadsAvailableScalingPolicyNames = "available_scaling_policy_names"
+
+	FieldWorkloadID                         = "id"
+	FieldWorkloadName                       = "name"
+	FieldWorkloadNamespace                  = "namespace"
+	FieldWorkloadKind                       = "kind"
+	FieldWorkloadLabels                     = "labels"
+	FieldWorkloadScalingPolicyID            = "scaling_policy_id"
+	FieldWorkloadScalingPolicyName          = "scaling_policy_name"
+	FieldWorkloadScalingPolicyOrigin        = "scaling_policy_origin"
+	FieldWorkloadVerticalManagementOption   = "vertical_management_option"
+	FieldWorkloadHorizontalManagementOption = "horizontal_management_option"
+	FieldWorkloadRecommendationStatus       = "recommendation_status"
+	FieldWorkloadRecommendationConfidence   = "recommendation_confidence"
+	FieldWorkloadError                      = "error"
+	FieldWorkloadContainers                 = "containers"
+
+	FieldWorkloadContainerName                 = "name"
+	FieldWorkloadContainerCpuRequest           = "cpu_request"
+	FieldWorkloadContainerMemoryRequestGib     = "memory_request_gib"
+	FieldWorkloadContainerRecommendedCpu       = "recommended_cpu_request"
+	FieldWorkloadContainerRecommendedMemoryGib = "recommended_memory_request_gib"
+)
+
+func dataSourceWorkloads() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWorkloadsRead,
+		Description: "Lists workloads of a cluster known to CAST AI workload autoscaler, optionally filtered by namespace, kind, " +
+			"labels and assigned scaling policy. Useful for checking which workloads scaling policy assignment rules select.",
+		Schema: map[string]*schema.Schema{
+			FieldClusterID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "CAST AI cluster id.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsUUID),
+			},
+			FieldWorkloadsNamespaces: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Only return workloads in these namespaces.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			FieldWorkloadsKinds: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Only return workloads of these kinds, e.g. `Deployment`.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			FieldWorkloadsScalingPolicyNames: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Only return workloads assigned to scaling policies with these names.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			FieldWorkloadsLabels: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Only return workloads which have all of these labels.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			FieldWorkloadsAvailableNamespaces: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Namespaces of all workloads of the cluster, regardless of the filters.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			FieldWorkloadsAvailableKinds: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Kinds of all workloads of the cluster, regardless of the filters.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			FieldWorkloadsAvailableScalingPolicyNames: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of scaling policies assigned to workloads of the cluster, regardless of the filters.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			FieldWorkloadsWorkloads: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Workloads matching the filters.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						FieldWorkloadID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Workload ID.",
+						},
+						FieldWorkloadName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Workload name.",
+						},
+						FieldWorkloadNamespace: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Namespace of the workload.",
+						},
+						FieldWorkloadKind: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Kind of the workload, e.g. `Deployment`.",
+						},
+						FieldWorkloadLabels: {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "Labels of the workload manifest.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						FieldWorkloadScalingPolicyID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the scaling policy assigned to the workload.",
+						},
+						FieldWorkloadScalingPolicyName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the scaling policy assigned to the workload.",
+						},
+						FieldWorkloadScalingPolicyOrigin: {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: "How the scaling policy was assigned: `ORIGIN_ASSIGNMENT_RULES`, `ORIGIN_API`, " +
+								"`ORIGIN_ANNOTATIONS` or `ORIGIN_DEFAULT`.",
+						},
+						FieldWorkloadVerticalManagementOption: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Vertical autoscaling management option of the workload: `MANAGED` or `READ_ONLY`.",
+						},
+						FieldWorkloadHorizontalManagementOption: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Horizontal autoscaling management option of the workload: `MANAGED` or `READ_ONLY`.",
+						},
+						FieldWorkloadRecommendationStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the workload's recommendation, e.g. `STATUS_APPLIED` or `STATUS_WAITING`.",
+						},
+						FieldWorkloadRecommendationConfidence: {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Confidence of the recommendation between 0 and 1. 0 when there is no recommendation yet.",
+						},
+						FieldWorkloadError: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Error preventing the workload from being optimized, if any.",
+						},
+						FieldWorkloadContainers: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Containers of the workload with their current and recommended requests.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									FieldWorkloadContainerName: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Container name.",
+									},
+									FieldWorkloadContainerCpuRequest: {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "Current CPU request in cores.",
+									},
+									FieldWorkloadContainerMemoryRequestGib: {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "Current memory request in GiB.",
+									},
+									FieldWorkloadContainerRecommendedCpu: {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "Recommended CPU request in cores.",
+									},
+									FieldWorkloadContainerRecommendedMemoryGib: {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "Recommended memory request in GiB.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceWorkloadsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+
+	clusterID := d.Get(FieldClusterID).(string)
+	params := &sdk.WorkloadOptimizationAPIListWorkloadsParams{}
+	if v := toStringList(d.Get(FieldWorkloadsNamespaces).([]any)); len(v) > 0 {
+		params.Namespaces = &v
+	}
+	if v := toStringList(d.Get(FieldWorkloadsKinds).([]any)); len(v) > 0 {
+		params.Kinds = &v
+	}
+	if v := toStringList(d.Get(FieldWorkloadsScalingPolicyNames).([]any)); len(v) > 0 {
+		params.ScalingPolicyNames = &v
+	}
+	// Workloads can't be filtered by labels in the API.
+	labels := toStringMap(d.Get(FieldWorkloadsLabels).(map[string]any))
+
+	workloads := make([]map[string]any, 0)
+	for {
+		resp, err := client.WorkloadOptimizationAPIListWorkloadsWithResponse(ctx, clusterID, params)
+		if err := sdk.CheckOKResponse(resp, err); err != nil {
+			return diag.FromErr(fmt.Errorf("listing workloads: %w", err))
+		}
+
+		for _, workload := range resp.JSON200.Workloads {
+			if !containsAllEntries(workloadKeyValues(workload.Labels), labels) {
+				continue
+			}
+			workloads = append(workloads, flattenWorkload(workload))
+		}
+
+		if lo.FromPtr(resp.JSON200.NextCursor) == "" {
+			break
+		}
+		params.PageCursor = resp.JSON200.NextCursor
+	}
+
+	filters, err := client.WorkloadOptimizationAPIGetWorkloadFiltersWithResponse(ctx, clusterID, &sdk.WorkloadOptimizationAPIGetWorkloadFiltersParams{})
+	if err := sdk.CheckOKResponse(filters, err); err != nil {
+		return diag.FromErr(fmt.Errorf("getting workload filters: %w", err))
+	}
+
+	d.SetId(clusterID)
+	if err := d.Set(FieldWorkloadsWorkloads, workloads); err != nil {
+		return diag.FromErr(fmt.Errorf("setting workloads: %w", err))
+	}
+	if err := d.Set(FieldWorkloadsAvailableNamespaces, filters.JSON200.Namespaces); err != nil {
+		return diag.FromErr(fmt.Errorf("setting available namespaces: %w", err))
+	}
+	if err := d.Set(FieldWorkloadsAvailableKinds, filters.JSON200.Kinds); err != nil {
+		return diag.FromErr(fmt.Errorf("setting available kinds: %w", err))
+	}
+	if err := d.Set(FieldWorkloadsAvailableScalingPolicyNames, filters.JSON200.ScalingPolicyNames); err != nil {
+		return diag.FromErr(fmt.Errorf("setting available scaling policy names: %w", err))
+	}
+
+	return nil
+}
+
+func flattenWorkload(workload sdk.WorkloadoptimizationV1Workload) map[string]any {
+	containers := make([]map[string]any, 0, len(workload.Containers))
+	for _, container := range workload.Containers {
+		requests := lo.FromPtr(lo.FromPtr(container.Resources).Requests)
+		recommended := lo.FromPtr(lo.FromPtr(container.Recommendation).Requests)
+		containers = append(containers, map[string]any{
+			FieldWorkloadContainerName:                 container.Name,
+			FieldWorkloadContainerCpuRequest:           lo.FromPtr(requests.CpuCores),
+			FieldWorkloadContainerMemoryRequestGib:     lo.FromPtr(requests.MemoryGib),
+			FieldWorkloadContainerRecommendedCpu:       lo.FromPtr(recommended.CpuCores),
+			FieldWorkloadContainerRecommendedMemoryGib: lo.FromPtr(recommended.MemoryGib),
+		})
+	}
+
+	return map[string]any{
+		FieldWorkloadID:                         workload.Id,
+		FieldWorkloadName:                       workload.Name,
+		FieldWorkloadNamespace:                  workload.Namespace,
+		FieldWorkloadKind:                       workload.Kind,
+		FieldWorkloadLabels:                     workloadKeyValues(workload.Labels),
+		FieldWorkloadScalingPolicyID:            workload.ScalingPolicyId,
+		FieldWorkloadScalingPolicyName:          workload.ScalingPolicyName,
+		FieldWorkloadScalingPolicyOrigin:        string(workload.ScalingPolicyOrigin),
+		FieldWorkloadVerticalManagementOption:   string(workload.WorkloadConfigV2.VpaConfig.ManagementOption),
+		FieldWorkloadHorizontalManagementOption: string(workload.WorkloadConfigV2.HpaConfig.ManagementOption),
+		FieldWorkloadRecommendationStatus:       string(workload.RecommendationStatus.Type),
+		FieldWorkloadRecommendationConfidence:   lo.FromPtr(workload.Recommendation).Confidence,
+		FieldWorkloadError:                      lo.FromPtr(workload.Error),
+		FieldWorkloadContainers:                 containers,
+	}
+}
+
+func workloadKeyValues(pairs []sdk.WorkloadoptimizationV1KeyValuePair) map[string]string {
+	return lo.SliceToMap(pairs, func(pair sdk.WorkloadoptimizationV1KeyValuePair) (string, string) {
+		return pair.Key, pair.Value
+	})
+}
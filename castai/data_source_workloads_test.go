@@ -0,0 +1,132 @@
+package castai
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+	mock_sdk "github.com/castai/terraform-provider-castai/castai/sdk/mock"
+)
+
+func TestDataSourceWorkloadsRead(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+	provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+	clusterID := "b6bfc074-a267-400f-b8f1-db0850c36af1"
+	newResponse := func(body string) *http.Response {
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(body))), Header: map[string][]string{"Content-Type": {"json"}}}
+	}
+
+	firstPage := `{
+  "workloads": [
+    {
+      "id": "workload-1",
+      "name": "api",
+      "namespace": "team-a",
+      "kind": "Deployment",
+      "labels": [{"key": "team", "value": "a"}, {"key": "tier", "value": "web"}],
+      "scalingPolicyId": "policy-1",
+      "scalingPolicyName": "web",
+      "scalingPolicyOrigin": "ORIGIN_ASSIGNMENT_RULES",
+      "recommendationStatus": {"type": "STATUS_APPLIED"},
+      "recommendation": {"confidence": 0.9},
+      "workloadConfigV2": {"vpaConfig": {"managementOption": "MANAGED"}, "hpaConfig": {"managementOption": "READ_ONLY"}},
+      "containers": [{
+        "name": "app",
+        "resources": {"requests": {"cpuCores": 1, "memoryGib": 2}},
+        "recommendation": {"requests": {"cpuCores": 0.25, "memoryGib": 0.5}}
+      }]
+    },
+    {
+      "id": "workload-2",
+      "name": "worker",
+      "namespace": "team-a",
+      "kind": "Deployment",
+      "labels": [{"key": "team", "value": "b"}]
+    }
+  ],
+  "nextCursor": "page-2"
+}`
+	secondPage := `{
+  "workloads": [
+    {
+      "id": "workload-3",
+      "name": "cache",
+      "namespace": "team-a",
+      "kind": "Deployment",
+      "labels": [{"key": "team", "value": "a"}],
+      "scalingPolicyName": "default",
+      "scalingPolicyOrigin": "ORIGIN_DEFAULT",
+      "error": "no metrics",
+      "containers": [{"name": "redis"}]
+    }
+  ]
+}`
+
+	gomock.InOrder(
+		mockClient.EXPECT().
+			WorkloadOptimizationAPIListWorkloads(gomock.Any(), clusterID, &sdk.WorkloadOptimizationAPIListWorkloadsParams{
+				Namespaces: lo.ToPtr([]string{"team-a"}),
+				Kinds:      lo.ToPtr([]string{"Deployment"}),
+			}).
+			Return(newResponse(firstPage), nil),
+		mockClient.EXPECT().
+			WorkloadOptimizationAPIListWorkloads(gomock.Any(), clusterID, &sdk.WorkloadOptimizationAPIListWorkloadsParams{
+				Namespaces: lo.ToPtr([]string{"team-a"}),
+				Kinds:      lo.ToPtr([]string{"Deployment"}),
+				PageCursor: lo.ToPtr("page-2"),
+			}).
+			Return(newResponse(secondPage), nil),
+		mockClient.EXPECT().
+			WorkloadOptimizationAPIGetWorkloadFilters(gomock.Any(), clusterID, gomock.Any()).
+			Return(newResponse(`{"namespaces": ["team-a", "team-b"], "kinds": ["Deployment", "StatefulSet"], "scalingPolicyNames": ["default", "web"]}`), nil),
+	)
+
+	data := schema.TestResourceDataRaw(t, dataSourceWorkloads().Schema, map[string]any{
+		FieldClusterID:           clusterID,
+		FieldWorkloadsNamespaces: []any{"team-a"},
+		FieldWorkloadsKinds:      []any{"Deployment"},
+		FieldWorkloadsLabels:     map[string]any{"team": "a"},
+	})
+
+	diags := dataSourceWorkloadsRead(context.Background(), data, provider)
+	r.Nil(diags)
+	r.Equal(clusterID, data.Id())
+	r.Equal([]any{"team-a", "team-b"}, data.Get(FieldWorkloadsAvailableNamespaces))
+	r.Equal([]any{"default", "web"}, data.Get(FieldWorkloadsAvailableScalingPolicyNames))
+
+	r.Equal(2, data.Get(FieldWorkloadsWorkloads+".#"))
+	api := data.Get(FieldWorkloadsWorkloads + ".0").(map[string]any)
+	r.Equal("api", api[FieldWorkloadName])
+	r.Equal(map[string]any{"team": "a", "tier": "web"}, api[FieldWorkloadLabels])
+	r.Equal("web", api[FieldWorkloadScalingPolicyName])
+	r.Equal("ORIGIN_ASSIGNMENT_RULES", api[FieldWorkloadScalingPolicyOrigin])
+	r.Equal("MANAGED", api[FieldWorkloadVerticalManagementOption])
+	r.Equal("READ_ONLY", api[FieldWorkloadHorizontalManagementOption])
+	r.Equal("STATUS_APPLIED", api[FieldWorkloadRecommendationStatus])
+	r.Equal(0.9, api[FieldWorkloadRecommendationConfidence])
+	r.Equal([]any{map[string]any{
+		FieldWorkloadContainerName:                 "app",
+		FieldWorkloadContainerCpuRequest:           1.0,
+		FieldWorkloadContainerMemoryRequestGib:     2.0,
+		FieldWorkloadContainerRecommendedCpu:       0.25,
+		FieldWorkloadContainerRecommendedMemoryGib: 0.5,
+	}}, api[FieldWorkloadContainers])
+
+	cache := data.Get(FieldWorkloadsWorkloads + ".1").(map[string]any)
+	r.Equal("cache", cache[FieldWorkloadName])
+	r.Equal("no metrics", cache[FieldWorkloadError])
+	r.Equal(0.0, cache[FieldWorkloadRecommendationConfidence])
+	r.Equal(0.0, data.Get(FieldWorkloadsWorkloads+".1."+FieldWorkloadContainers+".0."+FieldWorkloadContainerCpuRequest))
+}
@@ -0,0 +1,130 @@
+package castai
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/samber/lo"
+)
+
+const (
+	FieldZonesRegion            = "region"
+	FieldZonesZones             = "zones"
+	FieldZonesZoneID            = "id"
+	FieldZonesZoneName          = "name"
+	FieldZonesZoneZoneID        = "zone_id"
+	FieldZonesZoneRegion        = "region"
+	FieldZonesZoneCloudProvider = "cloud_provider"
+)
+
+func dataSourceZones() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists availability zones known to CAST AI inventory.",
+		ReadContext: dataSourceZonesRead,
+		Schema: map[string]*schema.Schema{
+			FieldInventoryCloudProvider: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Return only zones of the given cloud provider, e.g. `aws`, `gcp` or `azure`.",
+			},
+			FieldZonesRegion: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Return only zones of the given region.",
+			},
+			FieldInventoryNames: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of the matching zones.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			FieldZonesZones: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching zones.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						FieldZonesZoneID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Zone ID in CAST AI inventory.",
+						},
+						FieldZonesZoneName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Zone name.",
+						},
+						FieldZonesZoneZoneID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Zone ID assigned by the cloud provider.",
+						},
+						FieldZonesZoneRegion: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the region the zone belongs to.",
+						},
+						FieldZonesZoneCloudProvider: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Cloud provider of the zone.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceZonesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+	cloudProvider := d.Get(FieldInventoryCloudProvider).(string)
+	regionFilter := d.Get(FieldZonesRegion).(string)
+
+	zones, err := listInventoryZones(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// Zones don't carry their cloud provider, it comes from their region.
+	regions, err := listInventoryRegions(ctx, client, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	regionByReference := regionByZoneReference(regions)
+
+	var names []string
+	var items []map[string]any
+	for _, zone := range zones {
+		region, ok := regionByReference[lo.FromPtr(zone.Region)]
+		regionName := lo.Ternary(ok, lo.FromPtr(region.Name), lo.FromPtr(zone.Region))
+		if regionFilter != "" && regionName != regionFilter && lo.FromPtr(zone.Region) != regionFilter {
+			continue
+		}
+		if cloudProvider != "" && !strings.EqualFold(lo.FromPtr(region.Csp), cloudProvider) {
+			continue
+		}
+
+		names = append(names, lo.FromPtr(zone.Name))
+		items = append(items, map[string]any{
+			FieldZonesZoneID:            lo.FromPtr(zone.Id),
+			FieldZonesZoneName:          lo.FromPtr(zone.Name),
+			FieldZonesZoneZoneID:        lo.FromPtr(zone.ZoneId),
+			FieldZonesZoneRegion:        regionName,
+			FieldZonesZoneCloudProvider: lo.FromPtr(region.Csp),
+		})
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(names, ","))))
+	if err := d.Set(FieldInventoryNames, names); err != nil {
+		return diag.FromErr(fmt.Errorf("setting names: %w", err))
+	}
+	if err := d.Set(FieldZonesZones, items); err != nil {
+		return diag.FromErr(fmt.Errorf("setting zones: %w", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package castai
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+	mock_sdk "github.com/castai/terraform-provider-castai/castai/sdk/mock"
+)
+
+func TestZonesDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+	provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+	newResponse := func(body string) *http.Response {
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(body))), Header: map[string][]string{"Content-Type": {"json"}}}
+	}
+
+	mockClient.EXPECT().InventoryAPIListZones(gomock.Any(), gomock.Any()).Return(newResponse(`{
+  "zones": [
+    {"id": "z1", "name": "us-east-1a", "zoneId": "use1-az1", "region": "r1"},
+    {"id": "z2", "name": "us-east-1b", "zoneId": "use1-az2", "region": "r1"},
+    {"id": "z3", "name": "us-west-2a", "zoneId": "usw2-az1", "region": "r2"},
+    {"id": "z4", "name": "europe-west1-b", "region": "r3"}
+  ]
+}`), nil)
+	mockClient.EXPECT().InventoryAPIListRegions(gomock.Any(), gomock.Any()).Return(newResponse(`{
+  "regions": [
+    {"id": "r1", "name": "us-east-1", "csp": "aws"},
+    {"id": "r2", "name": "us-west-2", "csp": "aws"},
+    {"id": "r3", "name": "europe-west1", "csp": "gcp"}
+  ]
+}`), nil)
+
+	data := schema.TestResourceDataRaw(t, dataSourceZones().Schema, map[string]any{
+		FieldInventoryCloudProvider: "aws",
+		FieldZonesRegion:            "us-east-1",
+	})
+
+	diags := dataSourceZonesRead(context.Background(), data, provider)
+	r.Nil(diags)
+	r.Equal([]any{"us-east-1a", "us-east-1b"}, data.Get(FieldInventoryNames))
+	r.Equal("use1-az1", data.Get(FieldZonesZones+".0."+FieldZonesZoneZoneID))
+	r.Equal("us-east-1", data.Get(FieldZonesZones+".0."+FieldZonesZoneRegion))
+	r.Equal("aws", data.Get(FieldZonesZones+".0."+FieldZonesZoneCloudProvider))
+}
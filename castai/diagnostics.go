@@ -0,0 +1,152 @@
+package castai
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+)
+
+var apiFieldSegmentRegexp = regexp.MustCompile(`^([^\[]+)(?:\[(\d+)])?$`)
+
+// apiErrorDiagnostics converts err to diagnostics. The first diagnostic always carries the full error, which keeps
+// the raw API response visible. Each field violation of an *sdk.APIError is then reported as a separate diagnostic
+// attached to the attribute of resourceSchema that matches the violated API field.
+func apiErrorDiagnostics(err error, resourceSchema map[string]*schema.Schema) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+
+	diags := diag.FromErr(err)
+	apiErr, ok := sdk.AsAPIError(err)
+	if !ok {
+		return diags
+	}
+
+	for _, violation := range apiErr.FieldViolations {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("Invalid value for %q", violation.Field),
+			Detail:        violation.Description,
+			AttributePath: apiFieldToAttributePath(violation.Field, resourceSchema),
+		})
+	}
+
+	return diags
+}
+
+// diagnosticsError joins error diagnostics into a single error. It is used where diagnostics can't be returned, such as
+// CustomizeDiff. An error can't carry an attribute path, so Terraform reports it for the whole resource; the path is
+// only kept as a prefix of the message, and summaries shouldn't repeat it.
+func diagnosticsError(diags diag.Diagnostics) error {
+	var errs []error
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+
+		msg := d.Summary
+		if d.Detail != "" {
+			msg += ": " + d.Detail
+		}
+		if path := attributePathString(d.AttributePath); path != "" {
+			msg = path + ": " + msg
+		}
+		errs = append(errs, errors.New(msg))
+	}
+
+	return errors.Join(errs...)
+}
+
+// attributePathString formats path the way Terraform addresses attributes in state, e.g. "constraints.0.gpu".
+func attributePathString(path cty.Path) string {
+	parts := make([]string, 0, len(path))
+	for _, step := range path {
+		switch s := step.(type) {
+		case cty.GetAttrStep:
+			parts = append(parts, s.Name)
+		case cty.IndexStep:
+			if s.Key.Type() == cty.Number {
+				parts = append(parts, s.Key.AsBigFloat().String())
+			} else {
+				parts = append(parts, s.Key.AsString())
+			}
+		}
+	}
+
+	return strings.Join(parts, ".")
+}
+
+// apiFieldToAttributePath maps a dot separated API field, e.g. "constraints.instanceFamilies.include" or
+// "customTaints[1].key", to the attribute path of the matching attribute in s. Single item blocks are indexed
+// implicitly. Mapping stops at the first segment which cannot be resolved, returning the path resolved so far.
+func apiFieldToAttributePath(field string, s map[string]*schema.Schema) cty.Path {
+	var path cty.Path
+
+	for _, segment := range strings.Split(field, ".") {
+		if s == nil {
+			break
+		}
+
+		m := apiFieldSegmentRegexp.FindStringSubmatch(segment)
+		if m == nil {
+			break
+		}
+
+		name := camelToSnakeCase(m[1])
+		attr, ok := s[name]
+		if !ok {
+			break
+		}
+		path = path.GetAttr(name)
+		s = nil
+
+		if attr.Type != schema.TypeList && attr.Type != schema.TypeSet {
+			continue
+		}
+		elem, ok := attr.Elem.(*schema.Resource)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case m[2] != "" && attr.Type == schema.TypeList:
+			idx, _ := strconv.Atoi(m[2])
+			path = path.IndexInt(idx)
+		case attr.MaxItems == 1 && attr.Type == schema.TypeList:
+			path = path.IndexInt(0)
+		default:
+			continue
+		}
+		s = elem.Schema
+	}
+
+	return path
+}
+
+func camelToSnakeCase(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			prevLowerOrDigit := i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]))
+			acronymEnd := i > 0 && unicode.IsUpper(runes[i-1]) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if prevLowerOrDigit || acronymEnd {
+				b.WriteRune('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,104 @@
+package castai
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_apiFieldToAttributePath(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"name": {Type: schema.TypeString},
+		"settings": {
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"max_pods_per_node": {Type: schema.TypeInt},
+				},
+			},
+		},
+		"rules": {
+			Type: schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {Type: schema.TypeString},
+				},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		field string
+		want  cty.Path
+	}{
+		"top level attribute": {
+			field: "name",
+			want:  cty.GetAttrPath("name"),
+		},
+		"single item block": {
+			field: "settings.maxPodsPerNode",
+			want:  cty.GetAttrPath("settings").IndexInt(0).GetAttr("max_pods_per_node"),
+		},
+		"indexed list": {
+			field: "rules[2].key",
+			want:  cty.GetAttrPath("rules").IndexInt(2).GetAttr("key"),
+		},
+		"list without index": {
+			field: "rules.key",
+			want:  cty.GetAttrPath("rules"),
+		},
+		"unknown nested field": {
+			field: "settings.unknown",
+			want:  cty.GetAttrPath("settings").IndexInt(0),
+		},
+		"unknown field": {
+			field: "credentials",
+			want:  nil,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tt.want, apiFieldToAttributePath(tt.field, s))
+		})
+	}
+}
+
+func Test_camelToSnakeCase(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal("instance_families", camelToSnakeCase("instanceFamilies"))
+	r.Equal("min_cpu", camelToSnakeCase("minCpu"))
+	r.Equal("already_snake", camelToSnakeCase("already_snake"))
+	r.Equal("gpu_id", camelToSnakeCase("GPUId"))
+	r.Equal("ipv4_cidr", camelToSnakeCase("ipv4Cidr"))
+}
+
+func Test_apiErrorDiagnostics(t *testing.T) {
+	r := require.New(t)
+
+	r.Nil(apiErrorDiagnostics(nil, nil))
+
+	plain := apiErrorDiagnostics(fmt.Errorf("wrapped: %w", errors.New("boom")), nil)
+	r.Len(plain, 1)
+	r.Equal("wrapped: boom", plain[0].Summary)
+}
+
+func Test_diagnosticsError(t *testing.T) {
+	r := require.New(t)
+
+	r.NoError(diagnosticsError(nil))
+
+	err := diagnosticsError(diag.Diagnostics{
+		{Severity: diag.Warning, Summary: "ignored"},
+		{Severity: diag.Error, Summary: "boom"},
+		{Severity: diag.Error, Summary: "Invalid value", Detail: "must not be empty", AttributePath: cty.GetAttrPath("custom_taints").IndexInt(1).GetAttr("key")},
+	})
+	r.EqualError(err, "boom\ncustom_taints.1.key: Invalid value: must not be empty")
+}
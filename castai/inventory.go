@@ -0,0 +1,192 @@
+package castai
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/samber/lo"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+)
+
+// FieldValidateInventory enables checking referenced zones and instance types against CAST AI inventory.
+const FieldValidateInventory = "validate_inventory"
+
+func listInventoryRegions(ctx context.Context, client sdk.ClientWithResponsesInterface, includeUnavailable bool) ([]sdk.CastaiInventoryV1beta1Region, error) {
+	params := &sdk.InventoryAPIListRegionsParams{}
+	if includeUnavailable {
+		params.IncludeUnavailable = lo.ToPtr(true)
+	}
+
+	var regions []sdk.CastaiInventoryV1beta1Region
+	for {
+		resp, err := client.InventoryAPIListRegionsWithResponse(ctx, params)
+		if err := sdk.CheckOKResponse(resp, err); err != nil {
+			return nil, fmt.Errorf("listing regions: %w", err)
+		}
+
+		regions = append(regions, lo.FromPtr(resp.JSON200.Regions)...)
+		if lo.FromPtr(resp.JSON200.NextPageToken) == "" {
+			return regions, nil
+		}
+		params.PageToken = resp.JSON200.NextPageToken
+	}
+}
+
+func listInventoryZones(ctx context.Context, client sdk.ClientWithResponsesInterface) ([]sdk.CastaiInventoryV1beta1Zone, error) {
+	params := &sdk.InventoryAPIListZonesParams{}
+
+	var zones []sdk.CastaiInventoryV1beta1Zone
+	for {
+		resp, err := client.InventoryAPIListZonesWithResponse(ctx, params)
+		if err := sdk.CheckOKResponse(resp, err); err != nil {
+			return nil, fmt.Errorf("listing zones: %w", err)
+		}
+
+		zones = append(zones, lo.FromPtr(resp.JSON200.Zones)...)
+		if lo.FromPtr(resp.JSON200.NextPageToken) == "" {
+			return zones, nil
+		}
+		params.PageToken = resp.JSON200.NextPageToken
+	}
+}
+
+func listInventoryInstanceTypeNames(ctx context.Context, client sdk.ClientWithResponsesInterface, cloudProviders []string) ([]sdk.CastaiInventoryV1beta1InstanceTypeWithFamily, error) {
+	params := &sdk.InventoryAPIListInstanceTypeNamesParams{}
+	if len(cloudProviders) > 0 {
+		params.CloudServiceProviders = lo.ToPtr(cloudProviders)
+	}
+
+	var instanceTypes []sdk.CastaiInventoryV1beta1InstanceTypeWithFamily
+	for {
+		resp, err := client.InventoryAPIListInstanceTypeNamesWithResponse(ctx, params)
+		if err := sdk.CheckOKResponse(resp, err); err != nil {
+			return nil, fmt.Errorf("listing instance type names: %w", err)
+		}
+
+		instanceTypes = append(instanceTypes, lo.FromPtr(resp.JSON200.Items)...)
+		if lo.FromPtr(resp.JSON200.NextPageCursor) == "" {
+			return instanceTypes, nil
+		}
+		params.PageCursor = resp.JSON200.NextPageCursor
+	}
+}
+
+// regionByZoneReference indexes regions by ID and name, as zones may reference their region by either of them.
+func regionByZoneReference(regions []sdk.CastaiInventoryV1beta1Region) map[string]sdk.CastaiInventoryV1beta1Region {
+	out := make(map[string]sdk.CastaiInventoryV1beta1Region, 2*len(regions))
+	for _, region := range regions {
+		if id := lo.FromPtr(region.Id); id != "" {
+			out[id] = region
+		}
+		if name := lo.FromPtr(region.Name); name != "" {
+			out[name] = region
+		}
+	}
+	return out
+}
+
+// inventoryReference is a zone or instance type referenced by a resource, along with the attribute setting it.
+type inventoryReference struct {
+	value string
+	path  cty.Path
+}
+
+// inventoryDiagnostics returns a warning for every zone outside the region of one of the clusters and every instance type
+// the cluster's cloud provider doesn't offer according to CAST AI inventory. Zones match by name or zone ID. Failing to
+// load the clusters or inventory results in a warning too, as the check is advisory.
+func inventoryDiagnostics(ctx context.Context, client sdk.ClientWithResponsesInterface, clusterIDs []string, zones, instanceTypes []inventoryReference) diag.Diagnostics {
+	clusterIDs = lo.Uniq(lo.Compact(clusterIDs))
+	referenced := func(ref inventoryReference, _ int) bool { return ref.value != "" }
+	zones = lo.Filter(zones, referenced)
+	instanceTypes = lo.Filter(instanceTypes, referenced)
+	if len(clusterIDs) == 0 || len(zones) == 0 && len(instanceTypes) == 0 {
+		return nil
+	}
+
+	regions, err := listInventoryRegions(ctx, client, false)
+	if err != nil {
+		return diag.Diagnostics{inventoryUnavailableWarning(err)}
+	}
+	regionsByName := lo.KeyBy(regions, func(region sdk.CastaiInventoryV1beta1Region) string {
+		return lo.FromPtr(region.Name)
+	})
+	instanceTypesByProvider := map[string]map[string]struct{}{}
+
+	var diags diag.Diagnostics
+	for _, clusterID := range clusterIDs {
+		resp, err := client.ExternalClusterAPIGetClusterWithResponse(ctx, clusterID)
+		if err := sdk.CheckOKResponse(resp, err); err != nil {
+			return append(diags, inventoryUnavailableWarning(fmt.Errorf("getting cluster %s: %w", clusterID, err)))
+		}
+		regionName := lo.FromPtr(lo.FromPtr(resp.JSON200.Region).Name)
+		region, ok := regionsByName[regionName]
+		if !ok {
+			return append(diags, inventoryUnavailableWarning(fmt.Errorf("region %q of cluster %s not found in inventory", regionName, clusterID)))
+		}
+
+		knownZones := make(map[string]struct{}, 2*len(lo.FromPtr(region.Zones)))
+		for _, zone := range lo.FromPtr(region.Zones) {
+			knownZones[lo.FromPtr(zone.Name)] = struct{}{}
+			knownZones[lo.FromPtr(zone.ZoneId)] = struct{}{}
+		}
+		for _, zone := range zones {
+			if _, ok := knownZones[zone.value]; !ok {
+				diags = append(diags, diag.Diagnostic{
+					Severity:      diag.Warning,
+					Summary:       fmt.Sprintf("Zone %q not found in region %s of cluster %s", zone.value, regionName, clusterID),
+					Detail:        "Nodes can't be created in a zone outside the cluster's region. Check the castai_zones data source for available zones.",
+					AttributePath: zone.path,
+				})
+			}
+		}
+
+		if len(instanceTypes) == 0 {
+			continue
+		}
+		cloudProvider := strings.ToLower(lo.FromPtr(region.Csp))
+		knownInstanceTypes, ok := instanceTypesByProvider[cloudProvider]
+		if !ok {
+			inventoryInstanceTypes, err := listInventoryInstanceTypeNames(ctx, client, []string{cloudProvider})
+			if err != nil {
+				return append(diags, inventoryUnavailableWarning(err))
+			}
+			knownInstanceTypes = lo.SliceToMap(inventoryInstanceTypes, func(it sdk.CastaiInventoryV1beta1InstanceTypeWithFamily) (string, struct{}) {
+				return strings.ToLower(lo.FromPtr(it.InstanceType)), struct{}{}
+			})
+			instanceTypesByProvider[cloudProvider] = knownInstanceTypes
+		}
+		for _, instanceType := range instanceTypes {
+			if _, ok := knownInstanceTypes[strings.ToLower(instanceType.value)]; !ok {
+				diags = append(diags, diag.Diagnostic{
+					Severity:      diag.Warning,
+					Summary:       fmt.Sprintf("Instance type %q not offered by cloud provider %s of cluster %s", instanceType.value, cloudProvider, clusterID),
+					Detail:        "Nodes can't be created with an instance type CAST AI doesn't know about. Check the castai_instance_type_names data source for available instance types.",
+					AttributePath: instanceType.path,
+				})
+			}
+		}
+	}
+
+	return diags
+}
+
+func inventoryUnavailableWarning(err error) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Could not validate against CAST AI inventory",
+		Detail:   err.Error(),
+	}
+}
+
+// logInventoryWarnings logs warnings during plan, where diagnostics other than errors can't be returned. Callers report
+// the same warnings as diagnostics on apply.
+func logInventoryWarnings(ctx context.Context, diags diag.Diagnostics) {
+	for _, d := range diags {
+		tflog.Warn(ctx, d.Summary, map[string]any{"detail": d.Detail})
+	}
+}
@@ -0,0 +1,141 @@
+package castai
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+	mock_sdk "github.com/castai/terraform-provider-castai/castai/sdk/mock"
+)
+
+func TestInventoryDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	clusterID := "b6bfc074-a267-400f-b8f1-db0850c36ae1"
+	newResponse := func(body string) *http.Response {
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(body))), Header: map[string][]string{"Content-Type": {"json"}}}
+	}
+	regions := `{"regions": [
+  {"name": "us-east-1", "csp": "aws", "zones": [{"name": "us-east-1a", "zoneId": "use1-az1"}]},
+  {"name": "us-west-2", "csp": "aws", "zones": [{"name": "us-west-2a", "zoneId": "usw2-az1"}]}
+]}`
+	zonePath := cty.GetAttrPath("zone")
+	instanceTypePath := cty.GetAttrPath("instance_type")
+
+	t.Run("warns about zones outside the cluster region and instance types of other providers", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+
+		mockClient.EXPECT().InventoryAPIListRegions(gomock.Any(), gomock.Any()).
+			Return(newResponse(regions), nil)
+		mockClient.EXPECT().ExternalClusterAPIGetCluster(gomock.Any(), clusterID).
+			Return(newResponse(`{"id": "`+clusterID+`", "region": {"name": "us-east-1"}}`), nil)
+		mockClient.EXPECT().InventoryAPIListInstanceTypeNames(gomock.Any(), &sdk.InventoryAPIListInstanceTypeNamesParams{
+			CloudServiceProviders: &[]string{"aws"},
+		}).Return(newResponse(`{"items": [{"instanceType": "m5.large"}]}`), nil)
+
+		diags := inventoryDiagnostics(context.Background(), &sdk.ClientWithResponses{ClientInterface: mockClient}, []string{clusterID, clusterID},
+			[]inventoryReference{
+				{value: "us-east-1a", path: zonePath},
+				{value: "use1-az1", path: zonePath},
+				{value: "us-west-2a", path: zonePath},
+				{value: "", path: zonePath},
+			},
+			[]inventoryReference{
+				{value: "M5.large", path: instanceTypePath},
+				{value: "n2-standard-8", path: instanceTypePath},
+			},
+		)
+		r.Len(diags, 2)
+		r.Equal(diag.Warning, diags[0].Severity)
+		r.Equal(`Zone "us-west-2a" not found in region us-east-1 of cluster `+clusterID, diags[0].Summary)
+		r.Equal(zonePath, diags[0].AttributePath)
+		r.Equal(`Instance type "n2-standard-8" not offered by cloud provider aws of cluster `+clusterID, diags[1].Summary)
+		r.Equal(instanceTypePath, diags[1].AttributePath)
+	})
+
+	t.Run("skips inventory calls when nothing is referenced", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+
+		diags := inventoryDiagnostics(context.Background(), &sdk.ClientWithResponses{ClientInterface: mockClient}, []string{clusterID}, nil, nil)
+		r.Empty(diags)
+	})
+
+	t.Run("warns when inventory can't be loaded", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+
+		mockClient.EXPECT().InventoryAPIListRegions(gomock.Any(), gomock.Any()).
+			Return(&http.Response{StatusCode: 500, Body: io.NopCloser(bytes.NewReader([]byte(`{}`)))}, nil)
+
+		diags := inventoryDiagnostics(context.Background(), &sdk.ClientWithResponses{ClientInterface: mockClient}, []string{clusterID},
+			[]inventoryReference{{value: "us-east-1a", path: zonePath}}, nil)
+		r.Len(diags, 1)
+		r.Equal(diag.Warning, diags[0].Severity)
+		r.Equal("Could not validate against CAST AI inventory", diags[0].Summary)
+	})
+}
+
+func TestNodeTemplateInventoryReferences(t *testing.T) {
+	t.Parallel()
+	r := require.New(t)
+
+	zones, instanceTypes := nodeTemplateInventoryReferences([]any{
+		map[string]any{
+			FieldNodeTemplateAZs: []any{"us-east-1a", "us-east-1b"},
+			FieldNodeTemplateDedicatedNodeAffinity: []any{
+				map[string]any{
+					FieldNodeTemplateAzName:        "us-east-1c",
+					FieldNodeTemplateInstanceTypes: []any{"n2-standard-8"},
+				},
+			},
+		},
+	})
+	r.Equal([]string{"us-east-1a", "us-east-1b", "us-east-1c"}, lo.Map(zones, func(ref inventoryReference, _ int) string { return ref.value }))
+	r.Equal("constraints.0.dedicated_node_affinity.0.az_name", attributePathString(zones[2].path))
+	r.Len(instanceTypes, 1)
+	r.Equal("n2-standard-8", instanceTypes[0].value)
+	r.Equal("constraints.0.dedicated_node_affinity.0.instance_types", attributePathString(instanceTypes[0].path))
+}
+
+func TestNodeTemplateApplyWarnings_inventory(t *testing.T) {
+	t.Parallel()
+	r := require.New(t)
+	mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+	provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+	clusterID := "b6bfc074-a267-400f-b8f1-db0850c36ae2"
+
+	mockClient.EXPECT().InventoryAPIListRegions(gomock.Any(), gomock.Any()).
+		Return(&http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(`{"regions": [{"name": "us-east-1", "csp": "aws", "zones": [{"name": "us-east-1a"}]}]}`))), Header: map[string][]string{"Content-Type": {"json"}}}, nil)
+	mockClient.EXPECT().ExternalClusterAPIGetCluster(gomock.Any(), clusterID).
+		Return(&http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(`{"region": {"name": "us-east-1"}}`))), Header: map[string][]string{"Content-Type": {"json"}}}, nil)
+
+	data := schema.TestResourceDataRaw(t, resourceNodeTemplate().Schema, map[string]any{
+		FieldClusterId:         clusterID,
+		FieldNodeTemplateName:  "template",
+		FieldValidateInventory: true,
+		FieldNodeTemplateConstraints: []any{map[string]any{
+			FieldNodeTemplateAZs: []any{"us-east-1a", "eu-west-1a"},
+		}},
+	})
+
+	diags := nodeTemplateApplyWarnings(context.Background(), data, provider)
+	r.Len(diags, 1)
+	r.Equal(diag.Warning, diags[0].Severity)
+	r.Equal(`Zone "eu-west-1a" not found in region us-east-1 of cluster `+clusterID, diags[0].Summary)
+	r.Equal("constraints.0.azs", attributePathString(diags[0].AttributePath))
+}
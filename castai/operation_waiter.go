@@ -0,0 +1,85 @@
+package castai
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+)
+
+const (
+	operationPollInitialInterval = 500 * time.Millisecond
+	operationPollMaxInterval     = 10 * time.Second
+)
+
+// operationProbe checks the progress of asynchronous backend work. It returns done once the work reached a terminal
+// state and a short status which is logged while waiting. Returning an error stops waiting right away.
+type operationProbe func(ctx context.Context) (done bool, status string, err error)
+
+// waitForOperation waits until the CAST AI operation with the given ID is done and returns the operation error
+// if it failed.
+func waitForOperation(ctx context.Context, client sdk.ClientWithResponsesInterface, operationID string, timeout time.Duration) error {
+	return waitFor(ctx, fmt.Sprintf("operation %s", operationID), timeout, func(ctx context.Context) (bool, string, error) {
+		resp, err := client.OperationsAPIGetOperationWithResponse(ctx, operationID)
+		if err := sdk.CheckOKResponse(resp, err); err != nil {
+			return false, "", fmt.Errorf("getting operation %s: %w", operationID, err)
+		}
+
+		operation := resp.JSON200
+		if !lo.FromPtr(operation.Done) {
+			return false, "in progress", nil
+		}
+		if operation.Error != nil {
+			return true, "failed", fmt.Errorf("operation %s failed: %s: %s", operationID, lo.FromPtr(operation.Error.Reason), lo.FromPtr(operation.Error.Details))
+		}
+
+		return true, "done", nil
+	})
+}
+
+// waitFor calls probe with exponential backoff until it reports done, returns an error or the timeout elapses.
+// Zero timeout waits for as long as ctx allows.
+func waitFor(ctx context.Context, description string, timeout time.Duration, probe operationProbe) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	interval := operationPollInitialInterval
+	var lastStatus string
+	for {
+		done, status, err := probe(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return fmt.Errorf("waiting for %s: %w; last status: %s", description, ctx.Err(), lastStatus)
+			}
+			return err
+		}
+		if done {
+			tflog.Info(ctx, fmt.Sprintf("finished waiting for %s", description), map[string]any{
+				"status":  status,
+				"elapsed": time.Since(start).Round(time.Second).String(),
+			})
+			return nil
+		}
+
+		lastStatus = status
+		tflog.Info(ctx, fmt.Sprintf("waiting for %s", description), map[string]any{
+			"status":  status,
+			"elapsed": time.Since(start).Round(time.Second).String(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for %s: %w; last status: %s", description, ctx.Err(), lastStatus)
+		case <-time.After(interval):
+		}
+		interval = min(interval*2, operationPollMaxInterval)
+	}
+}
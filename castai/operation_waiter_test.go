@@ -0,0 +1,95 @@
+package castai
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+	mock_sdk "github.com/castai/terraform-provider-castai/castai/sdk/mock"
+)
+
+func TestWaitFor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("polls until probe reports done", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+
+		calls := 0
+		err := waitFor(context.Background(), "test", time.Minute, func(ctx context.Context) (bool, string, error) {
+			calls++
+			return calls == 2, "pending", nil
+		})
+		r.NoError(err)
+		r.Equal(2, calls)
+	})
+
+	t.Run("stops on probe error", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+
+		calls := 0
+		err := waitFor(context.Background(), "test", time.Minute, func(ctx context.Context) (bool, string, error) {
+			calls++
+			return false, "", errors.New("boom")
+		})
+		r.EqualError(err, "boom")
+		r.Equal(1, calls)
+	})
+
+	t.Run("returns last status on timeout", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+
+		err := waitFor(context.Background(), "test", 100*time.Millisecond, func(ctx context.Context) (bool, string, error) {
+			return false, "still deleting", nil
+		})
+		r.ErrorIs(err, context.DeadlineExceeded)
+		r.Contains(err.Error(), "waiting for test")
+		r.Contains(err.Error(), "last status: still deleting")
+	})
+}
+
+func TestWaitForOperation(t *testing.T) {
+	t.Parallel()
+
+	operationID := "4a4b3b3e-6e4a-4b6e-9f0e-2c1a2b3c4d5e"
+	newResponse := func(body string) *http.Response {
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(body))), Header: map[string][]string{"Content-Type": {"json"}}}
+	}
+
+	t.Run("succeeds when operation is done", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+
+		gomock.InOrder(
+			mockClient.EXPECT().OperationsAPIGetOperation(gomock.Any(), operationID).Return(newResponse(`{"done":false}`), nil),
+			mockClient.EXPECT().OperationsAPIGetOperation(gomock.Any(), operationID).Return(newResponse(`{"done":true}`), nil),
+		)
+
+		err := waitForOperation(context.Background(), &sdk.ClientWithResponses{ClientInterface: mockClient}, operationID, time.Minute)
+		r.NoError(err)
+	})
+
+	t.Run("surfaces operation error", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+
+		mockClient.EXPECT().
+			OperationsAPIGetOperation(gomock.Any(), operationID).
+			Return(newResponse(`{"done":true,"error":{"reason":"NodeCreationFailed","details":"out of capacity"}}`), nil)
+
+		err := waitForOperation(context.Background(), &sdk.ClientWithResponses{ClientInterface: mockClient}, operationID, time.Minute)
+		r.EqualError(err, "operation "+operationID+" failed: NodeCreationFailed: out of capacity")
+	})
+}
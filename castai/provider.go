@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -11,6 +13,7 @@ import (
 
 	"github.com/castai/terraform-provider-castai/castai/sdk"
 	"github.com/castai/terraform-provider-castai/castai/sdk/ai_optimizer"
+	"github.com/castai/terraform-provider-castai/castai/sdk/client"
 	"github.com/castai/terraform-provider-castai/castai/sdk/cluster_autoscaler"
 	"github.com/castai/terraform-provider-castai/castai/sdk/omni"
 	"github.com/castai/terraform-provider-castai/castai/sdk/organization_management"
@@ -51,42 +54,71 @@ func Provider(version string) *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("CASTAI_ORGANIZATION_ID", nil),
 				Description: "CAST AI organization ID. Required when the API token has access to multiple organizations.",
 			},
+			"max_retries": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("CASTAI_MAX_RETRIES", client.DefaultMaxRetries),
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      providerMaxRetriesDescription,
+			},
+			"retry_max_wait": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("CASTAI_RETRY_MAX_WAIT", client.DefaultRetryMaxWait.String()),
+				ValidateDiagFunc: validateDuration,
+				Description:      providerRetryMaxWaitDescription,
+			},
+			"request_timeout": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("CASTAI_REQUEST_TIMEOUT", client.DefaultRequestTimeout.String()),
+				ValidateDiagFunc: validateDuration,
+				Description:      providerRequestTimeoutDescription,
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"castai_eks_cluster":                resourceEKSCluster(),
-			"castai_eks_clusterid":              resourceEKSClusterID(),
-			"castai_gke_cluster":                resourceGKECluster(),
-			"castai_gke_cluster_id":             resourceGKEClusterId(),
-			"castai_aks_cluster":                resourceAKSCluster(),
-			"castai_autoscaler":                 resourceAutoscaler(),
-			"castai_evictor_advanced_config":    resourceEvictionConfig(),
-			"castai_node_template":              resourceNodeTemplate(),
-			"castai_rebalancing_schedule":       resourceRebalancingSchedule(),
-			"castai_rebalancing_job":            resourceRebalancingJob(),
-			"castai_node_configuration":         resourceNodeConfiguration(),
-			"castai_node_configuration_default": resourceNodeConfigurationDefault(),
-			"castai_eks_user_arn":               resourceEKSClusterUserARN(),
-			"castai_reservations":               resourceReservations(),
-			"castai_commitments":                resourceCommitments(),
-			"castai_organization_members":       resourceOrganizationMembers(),
-			"castai_sso_connection":             resourceSSOConnection(),
-			"castai_service_account":            resourceServiceAccount(),
-			"castai_service_account_key":        resourceServiceAccountKey(),
-			"castai_organization_group":         resourceOrganizationGroup(),
-			"castai_role_bindings":              resourceRoleBindings(),
-			"castai_hibernation_schedule":       resourceHibernationSchedule(),
-			"castai_security_runtime_rule":      resourceSecurityRuntimeRule(),
-			"castai_allocation_group":           resourceAllocationGroup(),
-			"castai_enterprise_group":           resourceEnterpriseGroup(),
-			"castai_enterprise_role_binding":    resourceEnterpriseRoleBinding(),
-			"castai_enterprise_service_account": resourceEnterpriseServiceAccount(),
-			"castai_cache_group":                resourceCacheGroup(),
-			"castai_cache_configuration":        resourceCacheConfiguration(),
-			"castai_cache_rule":                 resourceCacheRule(),
+			"castai_eks_cluster":                   resourceEKSCluster(),
+			"castai_eks_clusterid":                 resourceEKSClusterID(),
+			"castai_gke_cluster":                   resourceGKECluster(),
+			"castai_gke_cluster_id":                resourceGKEClusterId(),
+			"castai_aks_cluster":                   resourceAKSCluster(),
+			"castai_cluster_tags":                  resourceClusterTags(),
+			"castai_autoscaler":                    resourceAutoscaler(),
+			"castai_autoscaler_cluster_limits":     resourceAutoscalerClusterLimits(),
+			"castai_autoscaler_node_downscaler":    resourceAutoscalerNodeDownscaler(),
+			"castai_autoscaler_spot_instances":     resourceAutoscalerSpotInstances(),
+			"castai_autoscaler_unschedulable_pods": resourceAutoscalerUnschedulablePods(),
+			"castai_evictor_advanced_config":       resourceEvictionConfig(),
+			"castai_evictor_rule":                  resourceEvictorRule(),
+			"castai_node_template":                 resourceNodeTemplate(),
+			"castai_rebalancing_schedule":          resourceRebalancingSchedule(),
+			"castai_rebalancing_job":               resourceRebalancingJob(),
+			"castai_node_configuration":            resourceNodeConfiguration(),
+			"castai_node_configuration_default":    resourceNodeConfigurationDefault(),
+			"castai_eks_user_arn":                  resourceEKSClusterUserARN(),
+			"castai_reservations":                  resourceReservations(),
+			"castai_commitments":                   resourceCommitments(),
+			"castai_organization_members":          resourceOrganizationMembers(),
+			"castai_sso_connection":                resourceSSOConnection(),
+			"castai_service_account":               resourceServiceAccount(),
+			"castai_service_account_key":           resourceServiceAccountKey(),
+			"castai_organization_group":            resourceOrganizationGroup(),
+			"castai_role_bindings":                 resourceRoleBindings(),
+			"castai_hibernation_schedule":          resourceHibernationSchedule(),
+			"castai_cluster_hibernation_state":     resourceClusterHibernationState(),
+			"castai_security_runtime_rule":         resourceSecurityRuntimeRule(),
+			"castai_allocation_group":              resourceAllocationGroup(),
+			"castai_enterprise_group":              resourceEnterpriseGroup(),
+			"castai_enterprise_role_binding":       resourceEnterpriseRoleBinding(),
+			"castai_enterprise_service_account":    resourceEnterpriseServiceAccount(),
+			"castai_cache_group":                   resourceCacheGroup(),
+			"castai_cache_configuration":           resourceCacheConfiguration(),
+			"castai_cache_rule":                    resourceCacheRule(),
 
 			"castai_workload_scaling_policy":             resourceWorkloadScalingPolicy(),
 			"castai_workload_scaling_policy_order":       resourceWorkloadScalingPolicyOrder(),
+			"castai_workload_override":                   resourceWorkloadOverride(),
 			"castai_workload_custom_metrics_data_source": resourceWorkloadCustomMetricsDataSource(),
 
 			"castai_ai_optimizer_model_registry": resourceAIModelRegistry(),
@@ -96,15 +128,29 @@ func Provider(version string) *schema.Provider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"castai_eks_settings":                  dataSourceEKSSettings(),
-			"castai_gke_user_policies":             dataSourceGKEPolicies(),
-			"castai_organization":                  dataSourceOrganization(),
-			"castai_rebalancing_schedule":          dataSourceRebalancingSchedule(),
-			"castai_hibernation_schedule":          dataSourceHibernationSchedule(),
-			"castai_workload_scaling_policies":     dataSourceWorkloadScalingPolicies(),
-			"castai_workload_scaling_policy_order": dataSourceWorkloadScalingPolicyOrder(),
-			"castai_cache_group":                   dataSourceCacheGroup(),
-			"castai_impersonation_service_account": dataSourceImpersonationServiceAccount(),
+			"castai_eks_settings":                   dataSourceEKSSettings(),
+			"castai_gke_user_policies":              dataSourceGKEPolicies(),
+			"castai_organization":                   dataSourceOrganization(),
+			"castai_rebalancing_schedule":           dataSourceRebalancingSchedule(),
+			"castai_rebalancing_schedule_preview":   dataSourceRebalancingSchedulePreview(),
+			"castai_instance_types":                 dataSourceInstanceTypes(),
+			"castai_node_template_suggestions":      dataSourceNodeTemplateSuggestions(),
+			"castai_node_configuration_suggestion":  dataSourceNodeConfigurationSuggestion(),
+			"castai_regions":                        dataSourceRegions(),
+			"castai_zones":                          dataSourceZones(),
+			"castai_instance_type_names":            dataSourceInstanceTypeNames(),
+			"castai_autoscaler_node_constraints":    dataSourceAutoscalerNodeConstraints(),
+			"castai_allocation_group_costs":         dataSourceAllocationGroupCosts(),
+			"castai_allocation_group_efficiency":    dataSourceAllocationGroupEfficiency(),
+			"castai_allocation_group_data_transfer": dataSourceAllocationGroupDataTransfer(),
+			"castai_hibernation_schedule":           dataSourceHibernationSchedule(),
+			"castai_workload_scaling_policies":      dataSourceWorkloadScalingPolicies(),
+			"castai_workload_scaling_policy_order":  dataSourceWorkloadScalingPolicyOrder(),
+			"castai_workloads":                      dataSourceWorkloads(),
+			"castai_cache_group":                    dataSourceCacheGroup(),
+			"castai_impersonation_service_account":  dataSourceImpersonationServiceAccount(),
+			"castai_cluster_nodes":                  dataSourceClusterNodes(),
+			"castai_clusters":                       dataSourceClusters(),
 		},
 
 		ConfigureContextFunc: providerConfigure(version),
@@ -128,32 +174,41 @@ func providerConfigure(version string) schema.ConfigureContextFunc {
 			agent = fmt.Sprintf("%s %s", agent, addUA)
 		}
 
-		client, err := sdk.CreateClient(apiURL, apiToken, agent)
+		opts, err := httpClientOptions(
+			data.Get("max_retries").(int),
+			data.Get("retry_max_wait").(string),
+			data.Get("request_timeout").(string),
+		)
 		if err != nil {
 			return nil, diag.FromErr(err)
 		}
 
-		clusterAutoscalerClient, err := cluster_autoscaler.CreateClient(apiURL, apiToken, agent)
+		client, err := sdk.CreateClient(apiURL, apiToken, agent, opts...)
 		if err != nil {
 			return nil, diag.FromErr(err)
 		}
 
-		organizationManagementClient, err := organization_management.CreateClient(apiURL, apiToken, agent)
+		clusterAutoscalerClient, err := cluster_autoscaler.CreateClient(apiURL, apiToken, agent, opts...)
 		if err != nil {
 			return nil, diag.FromErr(err)
 		}
 
-		omniClient, err := omni.CreateClient(apiURL, apiToken, agent)
+		organizationManagementClient, err := organization_management.CreateClient(apiURL, apiToken, agent, opts...)
 		if err != nil {
 			return nil, diag.FromErr(err)
 		}
 
-		aiOptimizerClient, err := ai_optimizer.CreateClient(apiURL, apiToken, agent)
+		omniClient, err := omni.CreateClient(apiURL, apiToken, agent, opts...)
 		if err != nil {
 			return nil, diag.FromErr(err)
 		}
 
-		patchingEngineClient, err := patching_engine.CreateClient(apiURL, apiToken, agent)
+		aiOptimizerClient, err := ai_optimizer.CreateClient(apiURL, apiToken, agent, opts...)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		patchingEngineClient, err := patching_engine.CreateClient(apiURL, apiToken, agent, opts...)
 		if err != nil {
 			return nil, diag.FromErr(err)
 		}
@@ -169,3 +224,45 @@ func providerConfigure(version string) schema.ConfigureContextFunc {
 		}, nil
 	}
 }
+
+const (
+	providerMaxRetriesDescription     = "Maximum number of retries for requests failing with a rate limit (429) or, for idempotent requests, a server error. Set to 0 to disable retries. Defaults to 3."
+	providerRetryMaxWaitDescription   = "Maximum time to wait between two retries, including delays requested by the API via Retry-After, e.g. `30s`. Defaults to 30s."
+	providerRequestTimeoutDescription = "Timeout of a single request attempt to CAST AI API, e.g. `1m`. Defaults to 1m."
+)
+
+// httpClientOptions builds HTTP client options shared by all API clients from the provider settings.
+func httpClientOptions(maxRetries int, retryMaxWait, requestTimeout string) ([]client.Option, error) {
+	if maxRetries < 0 {
+		return nil, fmt.Errorf("max_retries must not be negative, got %d", maxRetries)
+	}
+	opts := []client.Option{client.WithMaxRetries(maxRetries)}
+
+	if retryMaxWait != "" {
+		wait, err := time.ParseDuration(retryMaxWait)
+		if err != nil {
+			return nil, fmt.Errorf("parsing retry_max_wait: %w", err)
+		}
+		opts = append(opts, client.WithRetryMaxWait(wait))
+	}
+
+	if requestTimeout != "" {
+		timeout, err := time.ParseDuration(requestTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("parsing request_timeout: %w", err)
+		}
+		opts = append(opts, client.WithRequestTimeout(timeout))
+	}
+
+	return opts, nil
+}
+
+// envInt returns the integer value of the environment variable or the fallback when it is not set.
+func envInt(key string, fallback int) (int, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback, nil
+	}
+
+	return strconv.Atoi(v)
+}
@@ -5,17 +5,21 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	tfprovider "github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/castai/terraform-provider-castai/castai/sdk"
 	"github.com/castai/terraform-provider-castai/castai/sdk/ai_optimizer"
+	"github.com/castai/terraform-provider-castai/castai/sdk/client"
 	"github.com/castai/terraform-provider-castai/castai/sdk/cluster_autoscaler"
 	omnisdk "github.com/castai/terraform-provider-castai/castai/sdk/omni"
 	"github.com/castai/terraform-provider-castai/castai/sdk/organization_management"
+	"github.com/castai/terraform-provider-castai/castai/validators"
 )
 
 var _ tfprovider.Provider = (*frameworkProvider)(nil)
@@ -28,6 +32,9 @@ type frameworkProviderModel struct {
 	APIUrl         types.String `tfsdk:"api_url"`
 	APIToken       types.String `tfsdk:"api_token"`
 	OrganizationID types.String `tfsdk:"organization_id"`
+	MaxRetries     types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait   types.String `tfsdk:"retry_max_wait"`
+	RequestTimeout types.String `tfsdk:"request_timeout"`
 }
 
 func NewFrameworkProvider(version string) tfprovider.Provider {
@@ -56,6 +63,27 @@ func (p *frameworkProvider) Schema(_ context.Context, _ tfprovider.SchemaRequest
 				Optional:    true,
 				Description: "CAST AI organization ID. Required when the API token has access to multiple organizations.",
 			},
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: providerMaxRetriesDescription,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.StringAttribute{
+				Optional:    true,
+				Description: providerRetryMaxWaitDescription,
+				Validators: []validator.String{
+					validators.ValidDuration(),
+				},
+			},
+			"request_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: providerRequestTimeoutDescription,
+				Validators: []validator.String{
+					validators.ValidDuration(),
+				},
+			},
 		},
 	}
 }
@@ -95,31 +123,56 @@ func (p *frameworkProvider) Configure(ctx context.Context, req tfprovider.Config
 		agent = fmt.Sprintf("%s %s", agent, addUA)
 	}
 
-	client, err := sdk.CreateClient(apiURL, apiToken, agent)
+	maxRetries, err := envInt("CASTAI_MAX_RETRIES", client.DefaultMaxRetries)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid CASTAI_MAX_RETRIES value", err.Error())
+		return
+	}
+	if !config.MaxRetries.IsNull() {
+		maxRetries = int(config.MaxRetries.ValueInt64())
+	}
+
+	retryMaxWait := config.RetryMaxWait.ValueString()
+	if retryMaxWait == "" {
+		retryMaxWait = os.Getenv("CASTAI_RETRY_MAX_WAIT")
+	}
+
+	requestTimeout := config.RequestTimeout.ValueString()
+	if requestTimeout == "" {
+		requestTimeout = os.Getenv("CASTAI_REQUEST_TIMEOUT")
+	}
+
+	opts, err := httpClientOptions(maxRetries, retryMaxWait, requestTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid HTTP client configuration", err.Error())
+		return
+	}
+
+	apiClient, err := sdk.CreateClient(apiURL, apiToken, agent, opts...)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create API client", err.Error())
 		return
 	}
 
-	clusterAutoscalerClient, err := cluster_autoscaler.CreateClient(apiURL, apiToken, agent)
+	clusterAutoscalerClient, err := cluster_autoscaler.CreateClient(apiURL, apiToken, agent, opts...)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create cluster autoscaler client", err.Error())
 		return
 	}
 
-	organizationManagementClient, err := organization_management.CreateClient(apiURL, apiToken, agent)
+	organizationManagementClient, err := organization_management.CreateClient(apiURL, apiToken, agent, opts...)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create organization management client", err.Error())
 		return
 	}
 
-	omniClient, err := omnisdk.CreateClient(apiURL, apiToken, agent)
+	omniClient, err := omnisdk.CreateClient(apiURL, apiToken, agent, opts...)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create omni client", err.Error())
 		return
 	}
 
-	aiOptimizerClient, err := ai_optimizer.CreateClient(apiURL, apiToken, agent)
+	aiOptimizerClient, err := ai_optimizer.CreateClient(apiURL, apiToken, agent, opts...)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create ai optimizer client", err.Error())
 		return
@@ -131,7 +184,7 @@ func (p *frameworkProvider) Configure(ctx context.Context, req tfprovider.Config
 	}
 
 	providerConfig := &ProviderConfig{
-		api:                          client,
+		api:                          apiClient,
 		clusterAutoscalerClient:      clusterAutoscalerClient,
 		organizationManagementClient: organizationManagementClient,
 		omniAPI:                      omniClient,
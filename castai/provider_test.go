@@ -67,6 +67,38 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestProviderMuxedSchemas(t *testing.T) {
+	server, err := testAccProtoV6ProviderFactories[ProviderName]()
+	if err != nil {
+		t.Fatalf("creating muxed provider: %v", err)
+	}
+
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("getting provider schema: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("provider schemas of SDKv2 and framework providers differ: %s: %s", d.Summary, d.Detail)
+		}
+	}
+}
+
+func TestHttpClientOptions(t *testing.T) {
+	if _, err := httpClientOptions(3, "30s", "1m"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := httpClientOptions(-1, "", ""); err == nil {
+		t.Fatal("expected error for negative max_retries")
+	}
+	if _, err := httpClientOptions(3, "soon", ""); err == nil {
+		t.Fatal("expected error for invalid retry_max_wait")
+	}
+	if _, err := httpClientOptions(3, "", "1 minute"); err == nil {
+		t.Fatal("expected error for invalid request_timeout")
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	testAccProviderConfigure.Do(func() {
 		if os.Getenv("CASTAI_API_URL") == "" {
@@ -6,11 +6,13 @@ import (
 	"math"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
 
 	"github.com/castai/terraform-provider-castai/castai/sdk"
 	"github.com/castai/terraform-provider-castai/castai/sdk/ai_optimizer"
@@ -74,6 +76,9 @@ func resourceAIHostedModel() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceAIHostedModelImporter,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			fieldAIHostedModelClusterID: {
 				Type:        schema.TypeString,
@@ -446,6 +451,23 @@ func resourceAIHostedModelDelete(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(fmt.Errorf("deleting hosted model: %w", err))
 	}
 
+	// Hosted model deletion is async, the model stays listed in DELETING status until it is removed from the cluster.
+	if err := waitFor(ctx, fmt.Sprintf("hosted model %s deletion", d.Id()), d.Timeout(schema.TimeoutDelete), func(ctx context.Context) (bool, string, error) {
+		model, err := findHostedModelByID(ctx, client, orgID, clusterID, d.Id())
+		if err != nil {
+			if isNotFoundError(err) {
+				return true, "deleted", nil
+			}
+			return false, "", fmt.Errorf("reading hosted model: %w", err)
+		}
+		if model == nil {
+			return true, "deleted", nil
+		}
+		return false, string(lo.FromPtr(model.Status)), nil
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
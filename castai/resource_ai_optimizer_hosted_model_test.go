@@ -525,6 +525,29 @@ func TestAIHostedModelDelete(t *testing.T) {
 					HTTPResponse: &http.Response{StatusCode: tc.statusCode},
 				}, nil)
 
+			if !tc.expectError {
+				deleting := ai_optimizer.HostedModelStatusDELETING
+				gomock.InOrder(
+					mockAIClient.EXPECT().
+						HostedModelsAPIListHostedModelsWithResponse(gomock.Any(), "org-1", "cluster-xyz", gomock.Any()).
+						Return(&ai_optimizer.HostedModelsAPIListHostedModelsResponse{
+							Body:         []byte(`{}`),
+							HTTPResponse: &http.Response{StatusCode: 200},
+							JSON200: &ai_optimizer.ListHostedModelsResponse{
+								Items:      []ai_optimizer.HostedModel{{Id: toPtr("model-abc"), Status: &deleting}},
+								TotalCount: 1,
+							},
+						}, nil),
+					mockAIClient.EXPECT().
+						HostedModelsAPIListHostedModelsWithResponse(gomock.Any(), "org-1", "cluster-xyz", gomock.Any()).
+						Return(&ai_optimizer.HostedModelsAPIListHostedModelsResponse{
+							Body:         []byte(`{}`),
+							HTTPResponse: &http.Response{StatusCode: 200},
+							JSON200:      &ai_optimizer.ListHostedModelsResponse{},
+						}, nil),
+				)
+			}
+
 			state := terraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{}), 0)
 			state.ID = "model-abc"
 			state.Attributes = map[string]string{
@@ -35,7 +35,10 @@ func resourceAKSCluster() *schema.Resource {
 		CreateContext: resourceCastaiAKSClusterCreate,
 		UpdateContext: resourceCastaiAKSClusterUpdate,
 		DeleteContext: resourceCastaiClusterDelete,
-		Description:   "AKS cluster resource allows connecting an existing AKS cluster to CAST AI.",
+		Importer: &schema.ResourceImporter{
+			StateContext: clusterStateImporter(importAKSClusterFields),
+		},
+		Description: "AKS cluster resource allows connecting an existing AKS cluster to CAST AI.",
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
@@ -212,6 +215,31 @@ func resourceCastaiAKSClusterRead(ctx context.Context, data *schema.ResourceData
 	return nil
 }
 
+// importAKSClusterFields sets fields which are only configured on create. client_id and client_secret are part of
+// the write-only credentials and stay unset.
+func importAKSClusterFields(cluster *sdk.ExternalclusterV1Cluster, data *schema.ResourceData) error {
+	if cluster.Aks == nil {
+		return fmt.Errorf("cluster %s is not an AKS cluster", data.Id())
+	}
+
+	if err := data.Set(FieldAKSClusterName, toString(cluster.Name)); err != nil {
+		return fmt.Errorf("setting cluster name: %w", err)
+	}
+	if err := data.Set(FieldAKSClusterSubscriptionID, toString(cluster.Aks.SubscriptionId)); err != nil {
+		return fmt.Errorf("setting subscription id: %w", err)
+	}
+	if err := data.Set(FieldAKSClusterNodeResourceGroup, toString(cluster.Aks.NodeResourceGroup)); err != nil {
+		return fmt.Errorf("setting node resource group: %w", err)
+	}
+	if cluster.Aks.TenantId != nil {
+		if err := data.Set(FieldAKSClusterTenantID, *cluster.Aks.TenantId); err != nil {
+			return fmt.Errorf("setting tenant id: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func resourceCastaiAKSClusterCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).api
 
@@ -575,3 +575,59 @@ resource "castai_aks_cluster" "test" {
 }
 `, subscriptionID, federationID, clusterName, tenantID, clientID)
 }
+
+func TestAKSClusterResourceImport(t *testing.T) {
+	r := require.New(t)
+	mockctrl := gomock.NewController(t)
+	mockClient := mock_sdk.NewMockClientInterface(mockctrl)
+
+	ctx := context.Background()
+	provider := &ProviderConfig{
+		api: &sdk.ClientWithResponses{
+			ClientInterface: mockClient,
+		},
+	}
+
+	clusterID := "b6bfc074-a267-400f-b8f1-db0850c369b1"
+	clusterResponse := `{
+  "id": "b6bfc074-a267-400f-b8f1-db0850c369b1",
+  "name": "aks-cluster",
+  "organizationId": "2836f775-aaaa-eeee-bbbb-3d3c29512692",
+  "credentialsId": "9b8d0456-177b-4a3d-b162-e68030d656aa",
+  "status": "ready",
+  "agentStatus": "online",
+  "providerType": "aks",
+  "aks": {
+    "nodeResourceGroup": "ng",
+    "region": "westeurope",
+    "subscriptionId": "subID",
+    "tenantId": "tenantID"
+  }
+}`
+	mockClient.EXPECT().
+		ExternalClusterAPIGetCluster(gomock.Any(), clusterID).
+		DoAndReturn(func(_ context.Context, _ string, _ ...sdk.RequestEditorFn) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(clusterResponse))), Header: map[string][]string{"Content-Type": {"json"}}}, nil
+		}).Times(2)
+
+	aksResource := resourceAKSCluster()
+	state := sdkterraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{}), 0)
+	state.ID = clusterID
+	data := aksResource.Data(state)
+
+	imported, err := aksResource.Importer.StateContext(ctx, data, provider)
+	r.NoError(err)
+	r.Len(imported, 1)
+
+	result := aksResource.ReadContext(ctx, imported[0], provider)
+	r.Nil(result)
+	r.Equal("aks-cluster", imported[0].Get(FieldAKSClusterName))
+	r.Equal("westeurope", imported[0].Get(FieldAKSClusterRegion))
+	r.Equal("subID", imported[0].Get(FieldAKSClusterSubscriptionID))
+	r.Equal("ng", imported[0].Get(FieldAKSClusterNodeResourceGroup))
+	r.Equal("tenantID", imported[0].Get(FieldAKSClusterTenantID))
+	r.Equal("9b8d0456-177b-4a3d-b162-e68030d656aa", imported[0].Get(FieldClusterCredentialsId))
+	// Credentials are write-only and must not be replaced with the drift marker.
+	r.Empty(imported[0].Get(FieldAKSClusterClientID))
+	r.Empty(imported[0].Get(FieldAKSClusterClientSecret))
+}
@@ -4,13 +4,16 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
 
 	"github.com/castai/terraform-provider-castai/castai/sdk"
 )
@@ -23,7 +26,11 @@ func resourceAllocationGroup() *schema.Resource {
 		DeleteContext: resourceAllocationGroupDelete,
 		Description:   "Manage allocation group. Allocation group [reference](https://docs.cast.ai/docs/allocation-groups)",
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: allocationGroupStateImporter,
+		},
+		CustomizeDiff: validateAllocationGroupReferences,
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(1 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -61,7 +68,7 @@ func resourceAllocationGroup() *schema.Resource {
 				Type: schema.TypeString,
 				Description: `Operator with which to connect the labels
 	OR (default) - workload needs to have at least one label to be included
-	AND - workload needs to have all the labels to be included`,
+	AND - workload needs to have all the labels to be included, requires labels to be set`,
 				Optional: true,
 				Default:  sdk.CostreportV1beta1FilterOperatorOR,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
@@ -207,27 +214,119 @@ func resourceAllocationGroupDelete(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	// Poll until the resource no longer exists
-	err = retry.RetryContext(ctx, 2*time.Second, func() *retry.RetryError {
+	// Deletion is async, the allocation group can still be read for a while.
+	if err := waitFor(ctx, fmt.Sprintf("allocation group %s deletion", d.Id()), d.Timeout(schema.TimeoutDelete), func(ctx context.Context) (bool, string, error) {
 		readresp, err := client.AllocationGroupAPIGetAllocationGroupWithResponse(ctx, d.Id())
 		if err != nil {
-			// Retryable error
-			return retry.RetryableError(err)
+			return false, err.Error(), nil
 		}
 		if readresp.StatusCode() == http.StatusNotFound {
-			// Resource is gone
-			return nil
+			return true, "deleted", nil
 		}
+		return false, "still exists", nil
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
 
-		// Still exists, retry
-		return retry.RetryableError(fmt.Errorf("resource %s still exists", d.Id()))
-	})
+func allocationGroupStateImporter(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*ProviderConfig).api
 
-	if err != nil {
-		return diag.Errorf("Error waiting for resource %s to be deleted: %s", d.Id(), err)
+	// if importing by UUID, nothing to do; if importing by name, fetch allocation group ID and set that as resource ID
+	if _, err := uuid.Parse(d.Id()); err != nil {
+		tflog.Info(ctx, "provided allocation group ID is not a UUID, will import by name")
+		groups, err := listAllocationGroups(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		matching := lo.Filter(groups, func(group sdk.CostreportV1beta1AllocationGroup, _ int) bool {
+			return lo.FromPtr(group.Name) == d.Id()
+		})
+		switch len(matching) {
+		case 0:
+			return nil, fmt.Errorf("allocation group %q was not found", d.Id())
+		case 1:
+			d.SetId(lo.FromPtr(matching[0].Id))
+		default:
+			ids := lo.Map(matching, func(group sdk.CostreportV1beta1AllocationGroup, _ int) string {
+				return lo.FromPtr(group.Id)
+			})
+			return nil, fmt.Errorf("allocation group name %q is ambiguous, import by one of IDs %s", d.Id(), strings.Join(ids, ", "))
+		}
 	}
 
-	return nil
+	return []*schema.ResourceData{d}, nil
+}
+
+// validateAllocationGroupReferences rejects the AND labels_operator without labels, cluster IDs of unknown clusters and
+// names taken by other allocation groups, which the API would otherwise only report on apply. Each check only runs when
+// the attributes it checks change. Failing to list clusters or allocation groups is logged and the respective check is
+// skipped.
+func validateAllocationGroupReferences(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	client := meta.(*ProviderConfig).api
+
+	var diags diag.Diagnostics
+	if d.NewValueKnown("labels") && d.NewValueKnown("labels_operator") && (d.Id() == "" || d.HasChanges("labels", "labels_operator")) &&
+		d.Get("labels_operator").(string) == string(sdk.CostreportV1beta1FilterOperatorAND) && len(d.Get("labels").(map[string]any)) == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "AND requires labels",
+			Detail:        "Set labels to select workloads, or remove labels_operator.",
+			AttributePath: cty.GetAttrPath("labels_operator"),
+		})
+	}
+
+	if d.NewValueKnown("cluster_ids") && (d.Id() == "" || d.HasChange("cluster_ids")) {
+		if clusterIDs := toStringList(d.Get("cluster_ids").(*schema.Set).List()); len(clusterIDs) > 0 {
+			resp, err := client.ExternalClusterAPIListClustersWithResponse(ctx)
+			if err := sdk.CheckOKResponse(resp, err); err != nil {
+				tflog.Warn(ctx, "Could not validate allocation group cluster_ids", map[string]any{"error": err.Error()})
+			} else {
+				known := lo.SliceToMap(lo.FromPtr(resp.JSON200.Items), func(cluster sdk.ExternalclusterV1Cluster) (string, struct{}) {
+					return lo.FromPtr(cluster.Id), struct{}{}
+				})
+				unknown := lo.Filter(clusterIDs, func(id string, _ int) bool {
+					_, ok := known[id]
+					return !ok
+				})
+				if len(unknown) > 0 {
+					diags = append(diags, diag.Diagnostic{
+						Severity:      diag.Error,
+						Summary:       fmt.Sprintf("clusters %s not found in the organization", strings.Join(unknown, ", ")),
+						AttributePath: cty.GetAttrPath("cluster_ids"),
+					})
+				}
+			}
+		}
+	}
+
+	if d.NewValueKnown("name") && (d.Id() == "" || d.HasChange("name")) {
+		name := d.Get("name").(string)
+		groups, err := listAllocationGroups(ctx, client)
+		if err != nil {
+			tflog.Warn(ctx, "Could not validate allocation group name", map[string]any{"error": err.Error()})
+		} else if group, ok := lo.Find(groups, func(group sdk.CostreportV1beta1AllocationGroup) bool {
+			return lo.FromPtr(group.Name) == name && lo.FromPtr(group.Id) != d.Id()
+		}); ok {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("allocation group %q already exists with ID %s", name, lo.FromPtr(group.Id)),
+				AttributePath: cty.GetAttrPath("name"),
+			})
+		}
+	}
+
+	return diagnosticsError(diags)
+}
+
+func listAllocationGroups(ctx context.Context, client sdk.ClientWithResponsesInterface) ([]sdk.CostreportV1beta1AllocationGroup, error) {
+	resp, err := client.AllocationGroupAPIListAllocationGroupsWithResponse(ctx, &sdk.AllocationGroupAPIListAllocationGroupsParams{})
+	if err := sdk.CheckOKResponse(resp, err); err != nil {
+		return nil, fmt.Errorf("listing allocation groups: %w", err)
+	}
+	return lo.FromPtr(resp.JSON200.Items), nil
 }
 
 func toLabelsOperator(d *schema.ResourceData) *sdk.CostreportV1beta1FilterOperator {
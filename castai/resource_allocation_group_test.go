@@ -1,16 +1,223 @@
 package castai
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 	"time"
 
+	"github.com/golang/mock/gomock"
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/require"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+	mock_sdk "github.com/castai/terraform-provider-castai/castai/sdk/mock"
 )
 
+const allocationGroupsBody = `{"items": [
+  {"id": "e5ee784d-2c4b-4820-ab4e-16e4b81534a4", "name": "team-a"},
+  {"id": "e5ee784d-2c4b-4820-ab4e-16e4b81534a5", "name": "team-b"}
+]}`
+
+func TestAllocationGroupResource_Import(t *testing.T) {
+	t.Parallel()
+
+	newResponse := func(body string) *http.Response {
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(body))), Header: map[string][]string{"Content-Type": {"json"}}}
+	}
+
+	t.Run("should import by ID", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		ag := resourceAllocationGroup()
+		data := ag.Data(&sdkterraform.InstanceState{ID: "e5ee784d-2c4b-4820-ab4e-16e4b81534a5"})
+
+		result, err := ag.Importer.StateContext(context.Background(), data, provider)
+		r.NoError(err)
+		r.Len(result, 1)
+		r.Equal("e5ee784d-2c4b-4820-ab4e-16e4b81534a5", result[0].Id())
+	})
+
+	t.Run("should import by name", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		mockClient.EXPECT().AllocationGroupAPIListAllocationGroups(gomock.Any(), gomock.Any()).
+			Return(newResponse(allocationGroupsBody), nil)
+
+		ag := resourceAllocationGroup()
+		data := ag.Data(&sdkterraform.InstanceState{ID: "team-b"})
+
+		result, err := ag.Importer.StateContext(context.Background(), data, provider)
+		r.NoError(err)
+		r.Len(result, 1)
+		r.Equal("e5ee784d-2c4b-4820-ab4e-16e4b81534a5", result[0].Id())
+	})
+
+	t.Run("should fail when name is not found", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		mockClient.EXPECT().AllocationGroupAPIListAllocationGroups(gomock.Any(), gomock.Any()).
+			Return(newResponse(allocationGroupsBody), nil)
+
+		ag := resourceAllocationGroup()
+		_, err := ag.Importer.StateContext(context.Background(), ag.Data(&sdkterraform.InstanceState{ID: "team-c"}), provider)
+		r.ErrorContains(err, `allocation group "team-c" was not found`)
+	})
+
+	t.Run("should fail when name is ambiguous", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		mockClient.EXPECT().AllocationGroupAPIListAllocationGroups(gomock.Any(), gomock.Any()).
+			Return(newResponse(`{"items": [
+  {"id": "e5ee784d-2c4b-4820-ab4e-16e4b81534a4", "name": "team-a"},
+  {"id": "e5ee784d-2c4b-4820-ab4e-16e4b81534a6", "name": "team-a"}
+]}`), nil)
+
+		ag := resourceAllocationGroup()
+		_, err := ag.Importer.StateContext(context.Background(), ag.Data(&sdkterraform.InstanceState{ID: "team-a"}), provider)
+		r.ErrorContains(err, `allocation group name "team-a" is ambiguous, import by one of IDs e5ee784d-2c4b-4820-ab4e-16e4b81534a4, e5ee784d-2c4b-4820-ab4e-16e4b81534a6`)
+	})
+}
+
+func TestAllocationGroupResource_Delete(t *testing.T) {
+	t.Parallel()
+	r := require.New(t)
+	mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+	provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+	groupID := "e5ee784d-2c4b-4820-ab4e-16e4b81534a4"
+	newResponse := func(status int, body string) *http.Response {
+		return &http.Response{StatusCode: status, Body: io.NopCloser(bytes.NewReader([]byte(body))), Header: map[string][]string{"Content-Type": {"json"}}}
+	}
+	gomock.InOrder(
+		mockClient.EXPECT().AllocationGroupAPIDeleteAllocationGroup(gomock.Any(), groupID).
+			Return(newResponse(http.StatusOK, `{}`), nil),
+		mockClient.EXPECT().AllocationGroupAPIGetAllocationGroup(gomock.Any(), groupID).
+			Return(newResponse(http.StatusOK, `{"id": "`+groupID+`", "name": "team-a"}`), nil),
+		mockClient.EXPECT().AllocationGroupAPIGetAllocationGroup(gomock.Any(), groupID).
+			Return(newResponse(http.StatusNotFound, `{}`), nil),
+	)
+
+	ag := resourceAllocationGroup()
+	data := ag.Data(&sdkterraform.InstanceState{ID: groupID})
+	r.Nil(ag.DeleteContext(context.Background(), data, provider))
+}
+
+func TestAllocationGroupResource_CustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	clustersBody := `{"items": [{"id": "b6bfc074-a267-400f-b8f1-db0850c36ae1"}]}`
+	newResponse := func(status int, body string) *http.Response {
+		return &http.Response{StatusCode: status, Body: io.NopCloser(bytes.NewReader([]byte(body))), Header: map[string][]string{"Content-Type": {"json"}}}
+	}
+
+	tests := map[string]struct {
+		name           string
+		clusterIDs     []any
+		labelsOperator string
+		clustersResp   *http.Response
+		wantErrs       []string
+	}{
+		"valid": {
+			name:         "team-c",
+			clusterIDs:   []any{"b6bfc074-a267-400f-b8f1-db0850c36ae1"},
+			clustersResp: newResponse(http.StatusOK, clustersBody),
+		},
+		"unknown cluster and duplicate name": {
+			name:         "team-a",
+			clusterIDs:   []any{"b6bfc074-a267-400f-b8f1-db0850c36ae1", "b6bfc074-a267-400f-b8f1-db0850c36ae2"},
+			clustersResp: newResponse(http.StatusOK, clustersBody),
+			wantErrs: []string{
+				"cluster_ids: clusters b6bfc074-a267-400f-b8f1-db0850c36ae2 not found in the organization",
+				`name: allocation group "team-a" already exists with ID e5ee784d-2c4b-4820-ab4e-16e4b81534a4`,
+			},
+		},
+		"AND labels operator without labels": {
+			name:           "team-c",
+			clusterIDs:     []any{"b6bfc074-a267-400f-b8f1-db0850c36ae1"},
+			labelsOperator: "AND",
+			clustersResp:   newResponse(http.StatusOK, clustersBody),
+			wantErrs:       []string{"labels_operator: AND requires labels: Set labels to select workloads, or remove labels_operator."},
+		},
+		"listing clusters fails": {
+			name:         "team-c",
+			clusterIDs:   []any{"b6bfc074-a267-400f-b8f1-db0850c36ae2"},
+			clustersResp: newResponse(http.StatusInternalServerError, `{}`),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			r := require.New(t)
+			mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+			provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+			mockClient.EXPECT().ExternalClusterAPIListClusters(gomock.Any()).Return(tt.clustersResp, nil)
+			mockClient.EXPECT().AllocationGroupAPIListAllocationGroups(gomock.Any(), gomock.Any()).
+				Return(newResponse(http.StatusOK, allocationGroupsBody), nil)
+
+			ag := resourceAllocationGroup()
+			config := map[string]any{
+				"name":        tt.name,
+				"cluster_ids": tt.clusterIDs,
+			}
+			if tt.labelsOperator != "" {
+				config["labels_operator"] = tt.labelsOperator
+			}
+			_, err := ag.Diff(context.Background(), nil, sdkterraform.NewResourceConfigRaw(config), provider)
+			if len(tt.wantErrs) == 0 {
+				r.NoError(err)
+				return
+			}
+			for _, want := range tt.wantErrs {
+				r.ErrorContains(err, want)
+			}
+		})
+	}
+
+	t.Run("should not reject AND labels operator in state when labels don't change", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		// The API isn't called, as neither the name nor cluster_ids change.
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mock_sdk.NewMockClientInterface(gomock.NewController(t))}}
+
+		ag := resourceAllocationGroup()
+		state := &sdkterraform.InstanceState{
+			ID: "e5ee784d-2c4b-4820-ab4e-16e4b81534a4",
+			Attributes: map[string]string{
+				"name":            "team-a",
+				"labels_operator": "AND",
+			},
+		}
+		config := map[string]any{
+			"name":            "team-a",
+			"labels_operator": "AND",
+			"namespaces":      []any{"default"},
+		}
+		diff, err := ag.Diff(context.Background(), state, sdkterraform.NewResourceConfigRaw(config), provider)
+		r.NoError(err)
+		r.NotNil(diff)
+	})
+}
+
 func TestAccCloudAgnostic_ResourceAllocationGroup(t *testing.T) {
 	resourceName := "castai_allocation_group.test"
 
@@ -8,16 +8,17 @@ import (
 	"io"
 	"log"
 	"net/http"
+	"reflect"
 	"strings"
+	"sync"
 	"time"
 
-	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/google/uuid"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"github.com/mitchellh/mapstructure"
+	"github.com/samber/lo"
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	"github.com/castai/terraform-provider-castai/castai/sdk"
@@ -65,7 +66,10 @@ const (
 	FieldEvictorNodeGracePeriodMinutes            = "node_grace_period_minutes"
 	FieldEvictorPodEvictionFailureBackOffInterval = "pod_eviction_failure_back_off_interval"
 	FieldEvictorIgnorePodDisruptionBudgets        = "ignore_pod_disruption_budgets"
+	FieldEvictorSoftTainting                      = "soft_tainting"
+	FieldEvictorCleanupKarpenterNodes             = "cleanup_karpenter_nodes"
 	FieldPodPinner                                = "pod_pinner"
+	FieldDiskGibToCpuRatio                        = "disk_gib_to_cpu_ratio"
 )
 
 func resourceAutoscaler() *schema.Resource {
@@ -85,414 +89,456 @@ func resourceAutoscaler() *schema.Resource {
 			Update: schema.DefaultTimeout(2 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
-			FieldClusterId: {
-				Type:             schema.TypeString,
-				Optional:         true,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.IsUUID),
-				Description:      "CAST AI cluster id",
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceAutoscalerV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: autoscalerStateUpgradeV0,
 			},
-			FieldAutoscalerPoliciesJSON: {
-				Type:             schema.TypeString,
-				Description:      "autoscaler policies JSON string to override current autoscaler settings",
-				Optional:         true,
-				ValidateDiagFunc: validateAutoscalerPolicyJSON(),
-				Deprecated:       "use autoscaler_settings instead. See README for example: https://github.com/castai/terraform-provider-castai?tab=readme-ov-file#migrating-from-6xx-to-7xx",
-			},
-			FieldAutoscalerPolicies: {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "computed value to store full policies configuration",
-				Deprecated:  "This field is deprecated and will be removed in the next major version. Use autoscaler_settings to configure and manage autoscaler policies.",
-			},
-			FieldAutoscalerSettings: {
-				Type:          schema.TypeList,
-				Optional:      true,
-				MaxItems:      1,
-				Description:   "autoscaler policy definitions to override current autoscaler settings",
-				ConflictsWith: []string{FieldAutoscalerPoliciesJSON},
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						FieldEnabled: {
-							Type:        schema.TypeBool,
-							Optional:    true,
-							Default:     false,
-							Description: "enable/disable autoscaler policies",
-						},
-						FieldIsScopedMode: {
-							Type:        schema.TypeBool,
-							Optional:    true,
-							Default:     false,
-							Description: "run autoscaler in scoped mode. Only marked pods and nodes will be considered.",
-						},
-						FieldNodeTemplatesPartialMatchingEnabled: {
-							Type:        schema.TypeBool,
-							Optional:    true,
-							Default:     false,
-							Description: "marks whether partial matching should be used when deciding which custom node template to select.",
-						},
-						FieldUnschedulablePods: {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "policy defining autoscaler's behavior when unschedulable pods were detected.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									FieldEnabled: {
-										Type:        schema.TypeBool,
-										Optional:    true,
-										Default:     false,
-										Description: "enable/disable unschedulable pods detection policy.",
-									},
-									FieldHeadroom: {
-										Type:        schema.TypeList,
-										Optional:    true,
-										MaxItems:    1,
-										Description: "additional headroom based on cluster's total available capacity for on-demand nodes.",
-										Deprecated:  "`headroom` is deprecated. Please refer to the FAQ for guidance on cluster headroom: https://docs.cast.ai/docs/autoscaler-1#can-you-please-share-some-guidance-on-cluster-headroom-i-would-like-to-add-some-buffer-room-so-that-pods-have-a-place-to-run-when-nodes-go-down",
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												FieldCPUPercentage: {
-													Type:             schema.TypeInt,
-													Optional:         true,
-													Default:          10,
-													Description:      "defines percentage of additional CPU capacity to be added.",
-													ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 100)),
-												},
-												FieldMemoryPercentage: {
-													Type:             schema.TypeInt,
-													Optional:         true,
-													Default:          10,
-													Description:      "defines percentage of additional memory capacity to be added.",
-													ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 100)),
-												},
-												FieldEnabled: {
-													Type:        schema.TypeBool,
-													Optional:    true,
-													Default:     true,
-													Description: "enable/disable headroom policy.",
-												},
+		},
+
+		Schema: autoscalerSchema(),
+	}
+}
+
+func autoscalerSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		FieldClusterId: {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsUUID),
+			Description:      "CAST AI cluster id",
+		},
+		FieldAutoscalerPoliciesJSON: {
+			Type:             schema.TypeString,
+			Description:      "autoscaler policies JSON string to override current autoscaler settings",
+			Optional:         true,
+			ValidateDiagFunc: validateAutoscalerPolicyJSON(),
+			Deprecated:       "use autoscaler_settings instead. See README for example: https://github.com/castai/terraform-provider-castai?tab=readme-ov-file#migrating-from-6xx-to-7xx",
+		},
+		FieldAutoscalerPolicies: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "computed value to store full policies configuration. Changes are planned per attribute on `autoscaler_settings`, so this value is only known after apply.",
+			Deprecated:  "This field is deprecated and will be removed in the next major version. Use autoscaler_settings to configure and manage autoscaler policies.",
+		},
+		FieldAutoscalerSettings: {
+			Type:          schema.TypeList,
+			Optional:      true,
+			Computed:      true,
+			MaxItems:      1,
+			Description:   "autoscaler policy definitions to override current autoscaler settings. Changes made outside of Terraform are refreshed per attribute. When `autoscaler_policies_json` is used instead, the block holds the current policies and changes of the JSON are planned on it.",
+			ConflictsWith: []string{FieldAutoscalerPoliciesJSON},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					FieldEnabled: {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+						Description: "enable/disable autoscaler policies",
+					},
+					FieldIsScopedMode: {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+						Description: "run autoscaler in scoped mode. Only marked pods and nodes will be considered.",
+					},
+					FieldNodeTemplatesPartialMatchingEnabled: {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+						Description: "marks whether partial matching should be used when deciding which custom node template to select.",
+					},
+					FieldUnschedulablePods: {
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Description: "policy defining autoscaler's behavior when unschedulable pods were detected.",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								FieldEnabled: {
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Default:     false,
+									Description: "enable/disable unschedulable pods detection policy.",
+								},
+								FieldHeadroom: {
+									Type:        schema.TypeList,
+									Optional:    true,
+									MaxItems:    1,
+									Description: "additional headroom based on cluster's total available capacity for on-demand nodes.",
+									Deprecated:  "`headroom` is deprecated. Please refer to the FAQ for guidance on cluster headroom: https://docs.cast.ai/docs/autoscaler-1#can-you-please-share-some-guidance-on-cluster-headroom-i-would-like-to-add-some-buffer-room-so-that-pods-have-a-place-to-run-when-nodes-go-down",
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											FieldCPUPercentage: {
+												Type:             schema.TypeInt,
+												Optional:         true,
+												Default:          10,
+												Description:      "defines percentage of additional CPU capacity to be added.",
+												ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 100)),
+											},
+											FieldMemoryPercentage: {
+												Type:             schema.TypeInt,
+												Optional:         true,
+												Default:          10,
+												Description:      "defines percentage of additional memory capacity to be added.",
+												ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 100)),
+											},
+											FieldEnabled: {
+												Type:        schema.TypeBool,
+												Optional:    true,
+												Default:     true,
+												Description: "enable/disable headroom policy.",
 											},
 										},
 									},
-									FieldHeadroomSpot: {
-										Type:        schema.TypeList,
-										Optional:    true,
-										MaxItems:    1,
-										Description: "additional headroom based on cluster's total available capacity for spot nodes.",
-										Deprecated:  "`headroom_spot` is deprecated. Please refer to the FAQ for guidance on cluster headroom: https://docs.cast.ai/docs/autoscaler-1#can-you-please-share-some-guidance-on-cluster-headroom-i-would-like-to-add-some-buffer-room-so-that-pods-have-a-place-to-run-when-nodes-go-down",
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												FieldCPUPercentage: {
-													Type:             schema.TypeInt,
-													Optional:         true,
-													Default:          10,
-													Description:      "defines percentage of additional CPU capacity to be added.",
-													ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 100)),
-												},
-												FieldMemoryPercentage: {
-													Type:             schema.TypeInt,
-													Optional:         true,
-													Default:          10,
-													Description:      "defines percentage of additional memory capacity to be added.",
-													ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 100)),
-												},
-												FieldEnabled: {
-													Type:        schema.TypeBool,
-													Optional:    true,
-													Default:     true,
-													Description: "enable/disable headroom_spot policy.",
-												},
+								},
+								FieldHeadroomSpot: {
+									Type:        schema.TypeList,
+									Optional:    true,
+									MaxItems:    1,
+									Description: "additional headroom based on cluster's total available capacity for spot nodes.",
+									Deprecated:  "`headroom_spot` is deprecated. Please refer to the FAQ for guidance on cluster headroom: https://docs.cast.ai/docs/autoscaler-1#can-you-please-share-some-guidance-on-cluster-headroom-i-would-like-to-add-some-buffer-room-so-that-pods-have-a-place-to-run-when-nodes-go-down",
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											FieldCPUPercentage: {
+												Type:             schema.TypeInt,
+												Optional:         true,
+												Default:          10,
+												Description:      "defines percentage of additional CPU capacity to be added.",
+												ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 100)),
+											},
+											FieldMemoryPercentage: {
+												Type:             schema.TypeInt,
+												Optional:         true,
+												Default:          10,
+												Description:      "defines percentage of additional memory capacity to be added.",
+												ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 100)),
+											},
+											FieldEnabled: {
+												Type:        schema.TypeBool,
+												Optional:    true,
+												Default:     true,
+												Description: "enable/disable headroom_spot policy.",
 											},
 										},
 									},
-									FieldNodeConstraints: {
-										Type:        schema.TypeList,
-										Optional:    true,
-										MaxItems:    1,
-										Description: "defines the node constraints that will be applied when autoscaling with Unschedulable Pods policy.",
-										Deprecated:  "`node_constraints` under `unschedulable_pods` is deprecated. Use the `constraints` field in the default castai_node_template resource instead. The default node template has `is_default = true`.",
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												FieldMinCPUCores: {
-													Type:        schema.TypeInt,
-													Optional:    true,
-													Default:     0,
-													Description: "defines min CPU cores for the node to pick.",
-												},
-												FieldMaxCPUCores: {
-													Type:        schema.TypeInt,
-													Optional:    true,
-													Default:     32,
-													Description: "defines max CPU cores for the node to pick.",
-												},
-												FieldMinRAMMiB: {
-													Type:        schema.TypeInt,
-													Optional:    true,
-													Default:     2048,
-													Description: "defines min RAM in MiB for the node to pick.",
-												},
-												FieldMaxRAMMiB: {
-													Type:        schema.TypeInt,
-													Optional:    true,
-													Default:     262144,
-													Description: "defines max RAM in MiB for the node to pick.",
-												},
-												FieldEnabled: {
-													Type:        schema.TypeBool,
-													Optional:    true,
-													Default:     false,
-													Description: "enable/disable node constraints policy.",
-												},
+								},
+								FieldNodeConstraints: {
+									Type:        schema.TypeList,
+									Optional:    true,
+									MaxItems:    1,
+									Description: "defines the node constraints that will be applied when autoscaling with Unschedulable Pods policy.",
+									Deprecated:  "`node_constraints` under `unschedulable_pods` is deprecated. Use the `constraints` field in the default castai_node_template resource instead. The default node template has `is_default = true`.",
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											FieldMinCPUCores: {
+												Type:             schema.TypeInt,
+												Optional:         true,
+												Default:          0,
+												Description:      "defines min CPU cores for the node to pick.",
+												ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+											},
+											FieldMaxCPUCores: {
+												Type:             schema.TypeInt,
+												Optional:         true,
+												Default:          32,
+												Description:      "defines max CPU cores for the node to pick.",
+												ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+											},
+											FieldMinRAMMiB: {
+												Type:             schema.TypeInt,
+												Optional:         true,
+												Default:          2048,
+												Description:      "defines min RAM in MiB for the node to pick.",
+												ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+											},
+											FieldMaxRAMMiB: {
+												Type:             schema.TypeInt,
+												Optional:         true,
+												Default:          262144,
+												Description:      "defines max RAM in MiB for the node to pick.",
+												ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+											},
+											FieldEnabled: {
+												Type:        schema.TypeBool,
+												Optional:    true,
+												Default:     false,
+												Description: "enable/disable node constraints policy.",
 											},
 										},
 									},
-									FieldPodPinner: {
-										Type:        schema.TypeList,
-										Optional:    true,
-										MaxItems:    1,
-										Description: "defines the Cast AI Pod Pinner components settings.",
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												FieldEnabled: {
-													Type:        schema.TypeBool,
-													Default:     false,
-													Optional:    true,
-													Description: "enable/disable the Pod Pinner component's automatic management in your cluster. Default: enabled.",
-												},
+								},
+								FieldPodPinner: {
+									Type:        schema.TypeList,
+									Optional:    true,
+									MaxItems:    1,
+									Description: "defines the Cast AI Pod Pinner components settings.",
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											FieldEnabled: {
+												Type:        schema.TypeBool,
+												Default:     false,
+												Optional:    true,
+												Description: "enable/disable the Pod Pinner component's automatic management in your cluster. Default: enabled.",
 											},
 										},
 									},
-									FieldCustomInstancesEnabled: {
-										Type:        schema.TypeBool,
-										Optional:    true,
-										Default:     false,
-										Deprecated:  "`custom_instances_enabled` under `unschedulable_pods.node_constraints` is deprecated. Use the `custom_instances_enabled` field in the default castai_node_template resource instead. The default node template has `is_default = true`.",
-										Description: "enable/disable custom instances policy.",
-									},
+								},
+								FieldCustomInstancesEnabled: {
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Computed:    true,
+									Deprecated:  "`custom_instances_enabled` under `unschedulable_pods.node_constraints` is deprecated. Use the `custom_instances_enabled` field in the default castai_node_template resource instead. The default node template has `is_default = true`.",
+									Description: "enable/disable custom instances policy. When not set, the value synced from the default node template is kept.",
+								},
+								FieldDiskGibToCpuRatio: {
+									Type:             schema.TypeInt,
+									Optional:         true,
+									Deprecated:       "`disk_gib_to_cpu_ratio` is ignored by CAST AI and will be removed in a future major version of the provider.",
+									Description:      "defines the ratio of 1 CPU to volume GiB which is added to the minimum volume size of new nodes. The policies API only accepts it for backwards compatibility and ignores it, so it isn't refreshed.",
+									ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
 								},
 							},
 						},
-						FieldClusterLimits: {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "defines minimum and maximum amount of CPU the cluster can have.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									FieldEnabled: {
-										Type:        schema.TypeBool,
-										Optional:    true,
-										Default:     true,
-										Description: "enable/disable cluster size limits policy.",
-									},
-									FieldCPU: {
-										Type:        schema.TypeList,
-										Optional:    true,
-										MaxItems:    1,
-										Description: "defines the minimum and maximum amount of CPUs for cluster's worker nodes.",
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												FieldMinCores: {
-													Type:             schema.TypeInt,
-													Optional:         true,
-													Default:          1,
-													Deprecated:       "This field is deprecated and will be removed in a future major version of the provider.",
-													Description:      "defines the minimum allowed amount of CPUs in the whole cluster. This field is deprecated.",
-													ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
-												},
-												FieldMaxCores: {
-													Type:             schema.TypeInt,
-													Optional:         true,
-													Default:          20,
-													Description:      "defines the maximum allowed amount of vCPUs in the whole cluster.",
-													ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(2)),
-												},
+					},
+					FieldClusterLimits: {
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Description: "defines minimum and maximum amount of CPU the cluster can have.",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								FieldEnabled: {
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Default:     true,
+									Description: "enable/disable cluster size limits policy.",
+								},
+								FieldCPU: {
+									Type:        schema.TypeList,
+									Optional:    true,
+									MaxItems:    1,
+									Description: "defines the minimum and maximum amount of CPUs for cluster's worker nodes.",
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											FieldMinCores: {
+												Type:             schema.TypeInt,
+												Optional:         true,
+												Default:          1,
+												Deprecated:       "This field is deprecated and will be removed in a future major version of the provider.",
+												Description:      "defines the minimum allowed amount of CPUs in the whole cluster. This field is deprecated.",
+												ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+											},
+											FieldMaxCores: {
+												Type:             schema.TypeInt,
+												Optional:         true,
+												Default:          20,
+												Description:      "defines the maximum allowed amount of vCPUs in the whole cluster.",
+												ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(2)),
 											},
 										},
 									},
 								},
 							},
 						},
-						FieldSpotInstances: {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "policy defining whether autoscaler can use spot instances for provisioning additional workloads.",
-							Deprecated:  "`spot_instances` is deprecated. Configure spot instance settings using the `constraints` field in the default castai_node_template resource. The default node template has `is_default = true`.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									FieldEnabled: {
-										Type:        schema.TypeBool,
-										Optional:    true,
-										Default:     false,
-										Deprecated:  "`enabled` under `spot_instances` is deprecated. To enable spot instances, set `constraints.spot = true` in the default castai_node_template resource. The default node template has `is_default = true`.",
-										Description: "enable/disable spot instances policy.",
-									},
-									FieldMaxReclaimRate: {
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Default:     0,
-										Deprecated:  "`max_reclaim_rate` under `spot_instances` is deprecated. This field has no direct equivalent in the castai_node_template resource, and setting it will have no effect.",
-										Description: "max allowed reclaim rate when choosing spot instance type. E.g. if the value is 10%, instance types having 10% or higher reclaim rate will not be considered. Set to zero to use all instance types regardless of reclaim rate.",
-									},
-									FieldSpotBackups: {
-										Type:        schema.TypeList,
-										Optional:    true,
-										MaxItems:    1,
-										Deprecated:  "`spot_backups` under `spot_instances` is deprecated. Configure spot backup behavior using `constraints.use_spot_fallbacks` and `constraints.fallback_restore_rate_seconds` in the default castai_node_template resource. The default node template has `is_default = true`.",
-										Description: "policy defining whether autoscaler can use spot backups instead of spot instances when spot instances are not available.",
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												FieldEnabled: {
-													Type:        schema.TypeBool,
-													Optional:    true,
-													Default:     false,
-													Description: "enable/disable spot backups policy.",
-												},
-												FieldSpotBackupRestoreRateSeconds: {
-													Type:             schema.TypeInt,
-													Optional:         true,
-													Default:          1800,
-													Description:      "defines interval on how often spot backups restore to real spot should occur.",
-													ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(60)),
-												},
+					},
+					FieldSpotInstances: {
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Description: "policy defining whether autoscaler can use spot instances for provisioning additional workloads.",
+						Deprecated:  "`spot_instances` is deprecated. Configure spot instance settings using the `constraints` field in the default castai_node_template resource. The default node template has `is_default = true`.",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								FieldEnabled: {
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Default:     false,
+									Deprecated:  "`enabled` under `spot_instances` is deprecated. To enable spot instances, set `constraints.spot = true` in the default castai_node_template resource. The default node template has `is_default = true`.",
+									Description: "enable/disable spot instances policy.",
+								},
+								FieldMaxReclaimRate: {
+									Type:             schema.TypeInt,
+									Optional:         true,
+									Default:          0,
+									Deprecated:       "`max_reclaim_rate` under `spot_instances` is deprecated. This field has no direct equivalent in the castai_node_template resource, and setting it will have no effect.",
+									Description:      "max allowed reclaim rate when choosing spot instance type. E.g. if the value is 10%, instance types having 10% or higher reclaim rate will not be considered. Set to zero to use all instance types regardless of reclaim rate.",
+									ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 100)),
+								},
+								FieldSpotBackups: {
+									Type:        schema.TypeList,
+									Optional:    true,
+									MaxItems:    1,
+									Deprecated:  "`spot_backups` under `spot_instances` is deprecated. Configure spot backup behavior using `constraints.use_spot_fallbacks` and `constraints.fallback_restore_rate_seconds` in the default castai_node_template resource. The default node template has `is_default = true`.",
+									Description: "policy defining whether autoscaler can use spot backups instead of spot instances when spot instances are not available.",
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											FieldEnabled: {
+												Type:        schema.TypeBool,
+												Optional:    true,
+												Default:     false,
+												Description: "enable/disable spot backups policy.",
+											},
+											FieldSpotBackupRestoreRateSeconds: {
+												Type:             schema.TypeInt,
+												Optional:         true,
+												Default:          1800,
+												Description:      "defines interval on how often spot backups restore to real spot should occur.",
+												ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(60)),
 											},
 										},
 									},
-									FieldSpotDiversityEnabled: {
-										Type:        schema.TypeBool,
-										Optional:    true,
-										Default:     false,
-										Deprecated:  "`spot_diversity_enabled` is deprecated. Use the `enable_spot_diversity` field within `castai_node_template.constraints` in the default castai_node_template resource. The default node template has `is_default = true`.",
-										Description: "enable/disable spot diversity policy. When enabled, autoscaler will try to balance between diverse and cost optimal instance types.",
-									},
-									FieldSpotDiversityPriceIncreaseLimit: {
-										Type:             schema.TypeInt,
-										Optional:         true,
-										Default:          20,
-										Deprecated:       "`spot_diversity_price_increase_limit` is deprecated. Use `spot_diversity_price_increase_limit_percent` within `castai_node_template.constraints` in the default castai_node_template resource. The default node template has `is_default = true`.",
-										Description:      "allowed node configuration price increase when diversifying instance types. E.g. if the value is 10%, then the overall price of diversified instance types can be 10% higher than the price of the optimal configuration.",
-										ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
-									},
-									FieldSpotInterruptionPredictions: {
-										Type:        schema.TypeList,
-										Optional:    true,
-										MaxItems:    1,
-										Description: "configure the handling of SPOT interruption predictions.",
-										Deprecated:  "`spot_interruption_predictions` is deprecated. Use the `spot_interruption_predictions_enabled` and `spot_interruption_predictions_type` fields in the default castai_node_template resource. The default node template has `is_default = true`.",
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												FieldEnabled: {
-													Type:        schema.TypeBool,
-													Optional:    true,
-													Default:     false,
-													Description: "enable/disable spot interruption predictions.",
-												},
-												FieldSpotInterruptionPredictionsType: {
-													Type:             schema.TypeString,
-													Optional:         true,
-													Default:          "CASTAIInterruptionPredictions",
-													Description:      "define the type of the spot interruption prediction to handle. The value \"AWSRebalanceRecommendations\" is deprecated; use \"CASTAIInterruptionPredictions\".",
-													Deprecated:       "The value \"AWSRebalanceRecommendations\" is deprecated. Cast AI ML predictions are now used for all spot interruption prediction.",
-													ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"AWSRebalanceRecommendations", "CASTAIInterruptionPredictions"}, false)),
-												},
+								},
+								FieldSpotDiversityEnabled: {
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Default:     false,
+									Deprecated:  "`spot_diversity_enabled` is deprecated. Use the `enable_spot_diversity` field within `castai_node_template.constraints` in the default castai_node_template resource. The default node template has `is_default = true`.",
+									Description: "enable/disable spot diversity policy. When enabled, autoscaler will try to balance between diverse and cost optimal instance types.",
+								},
+								FieldSpotDiversityPriceIncreaseLimit: {
+									Type:             schema.TypeInt,
+									Optional:         true,
+									Computed:         true,
+									Deprecated:       "`spot_diversity_price_increase_limit` is deprecated. Use `spot_diversity_price_increase_limit_percent` within `castai_node_template.constraints` in the default castai_node_template resource. The default node template has `is_default = true`.",
+									Description:      "allowed node configuration price increase when diversifying instance types. E.g. if the value is 10%, then the overall price of diversified instance types can be 10% higher than the price of the optimal configuration. When not set, the value synced from the default node template is kept.",
+									ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+								},
+								FieldSpotInterruptionPredictions: {
+									Type:        schema.TypeList,
+									Optional:    true,
+									MaxItems:    1,
+									Description: "configure the handling of SPOT interruption predictions.",
+									Deprecated:  "`spot_interruption_predictions` is deprecated. Use the `spot_interruption_predictions_enabled` and `spot_interruption_predictions_type` fields in the default castai_node_template resource. The default node template has `is_default = true`.",
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											FieldEnabled: {
+												Type:        schema.TypeBool,
+												Optional:    true,
+												Default:     false,
+												Description: "enable/disable spot interruption predictions.",
+											},
+											FieldSpotInterruptionPredictionsType: {
+												Type:             schema.TypeString,
+												Optional:         true,
+												Computed:         true,
+												Description:      "define the type of the spot interruption prediction to handle. The value \"AWSRebalanceRecommendations\" is deprecated; use \"CASTAIInterruptionPredictions\". When not set, the value synced from the default node template is kept.",
+												Deprecated:       "The value \"AWSRebalanceRecommendations\" is deprecated. Cast AI ML predictions are now used for all spot interruption prediction.",
+												ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"AWSRebalanceRecommendations", "CASTAIInterruptionPredictions"}, false)),
 											},
 										},
 									},
 								},
 							},
 						},
-						FieldNodeDownscaler: {
-							Type:        schema.TypeList,
-							Optional:    true,
-							MaxItems:    1,
-							Description: "node downscaler defines policies for removing nodes based on the configured conditions.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									FieldEnabled: {
-										Type:        schema.TypeBool,
-										Optional:    true,
-										Default:     true,
-										Description: "enable/disable node downscaler policy.",
-									},
-									FieldEmptyNodes: {
-										Type:        schema.TypeList,
-										Optional:    true,
-										MaxItems:    1,
-										Description: "defines whether Node Downscaler should opt in for removing empty worker nodes when possible.",
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												FieldEnabled: {
-													Type:        schema.TypeBool,
-													Optional:    true,
-													Default:     false,
-													Description: "enable/disable the empty worker nodes policy.",
-												},
-												FieldDelaySeconds: {
-													Type:        schema.TypeInt,
-													Optional:    true,
-													Default:     300,
-													Description: "period (in seconds) to wait before removing the node. Might be useful to control the aggressiveness of the downscaler.",
-												},
+					},
+					FieldNodeDownscaler: {
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Description: "node downscaler defines policies for removing nodes based on the configured conditions.",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								FieldEnabled: {
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Default:     true,
+									Description: "enable/disable node downscaler policy.",
+								},
+								FieldEmptyNodes: {
+									Type:        schema.TypeList,
+									Optional:    true,
+									MaxItems:    1,
+									Description: "defines whether Node Downscaler should opt in for removing empty worker nodes when possible.",
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											FieldEnabled: {
+												Type:        schema.TypeBool,
+												Optional:    true,
+												Default:     false,
+												Description: "enable/disable the empty worker nodes policy.",
+											},
+											FieldDelaySeconds: {
+												Type:             schema.TypeInt,
+												Optional:         true,
+												Default:          300,
+												Description:      "period (in seconds) to wait before removing the node. Might be useful to control the aggressiveness of the downscaler.",
+												ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
 											},
 										},
 									},
-									FieldEvictor: {
-										Type:        schema.TypeList,
-										Optional:    true,
-										MaxItems:    1,
-										Description: "defines the CAST AI Evictor component settings. Evictor watches the pods running in your cluster and looks for ways to compact them into fewer nodes, making nodes empty, which will be removed by the empty worker nodes policy.",
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												FieldEnabled: {
-													Type:        schema.TypeBool,
-													Optional:    true,
-													Default:     false,
-													Description: "enable/disable the Evictor policy. This will either install or uninstall the Evictor component in your cluster.",
-												},
-												FieldEvictorDryRun: {
-													Type:        schema.TypeBool,
-													Optional:    true,
-													Default:     false,
-													Description: "enable/disable dry-run. This property allows you to prevent the Evictor from carrying any operations out and preview the actions it would take.",
-												},
-												FieldEvictorAggressiveMode: {
-													Type:        schema.TypeBool,
-													Optional:    true,
-													Default:     false,
-													Description: "enable/disable aggressive mode. By default, Evictor does not target nodes that are running unreplicated pods. This mode will make the Evictor start considering application with just a single replica.",
-												},
-												FieldEvictorScopedMode: {
-													Type:        schema.TypeBool,
-													Optional:    true,
-													Default:     false,
-													Description: "enable/disable scoped mode. By default, Evictor targets all nodes in the cluster. This mode will constrain it to just the nodes which were created by CAST AI.",
-												},
-												FieldEvictorCycleInterval: {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Default:     "1m",
-													Description: "configure the interval duration between Evictor operations. This property can be used to lower or raise the frequency of the Evictor's find-and-drain operations.",
-												},
-												FieldEvictorNodeGracePeriodMinutes: {
-													Type:        schema.TypeInt,
-													Optional:    true,
-													Default:     5,
-													Description: "configure the node grace period which controls the duration which must pass after a node has been created before Evictor starts considering that node.",
-												},
-												FieldEvictorPodEvictionFailureBackOffInterval: {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Default:     "5s",
-													Description: "configure the pod eviction failure back off interval. If pod eviction fails then Evictor will attempt to evict it again after the amount of time specified here.",
-												},
-												FieldEvictorIgnorePodDisruptionBudgets: {
-													Type:        schema.TypeBool,
-													Optional:    true,
-													Default:     false,
-													Description: "if enabled then Evictor will attempt to evict pods that have pod disruption budgets configured.",
-												},
+								},
+								FieldEvictor: {
+									Type:        schema.TypeList,
+									Optional:    true,
+									MaxItems:    1,
+									Description: "defines the CAST AI Evictor component settings. Evictor watches the pods running in your cluster and looks for ways to compact them into fewer nodes, making nodes empty, which will be removed by the empty worker nodes policy.",
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											FieldEnabled: {
+												Type:        schema.TypeBool,
+												Optional:    true,
+												Default:     false,
+												Description: "enable/disable the Evictor policy. This will either install or uninstall the Evictor component in your cluster.",
+											},
+											FieldEvictorDryRun: {
+												Type:        schema.TypeBool,
+												Optional:    true,
+												Default:     false,
+												Description: "enable/disable dry-run. This property allows you to prevent the Evictor from carrying any operations out and preview the actions it would take.",
+											},
+											FieldEvictorAggressiveMode: {
+												Type:        schema.TypeBool,
+												Optional:    true,
+												Default:     false,
+												Description: "enable/disable aggressive mode. By default, Evictor does not target nodes that are running unreplicated pods. This mode will make the Evictor start considering application with just a single replica.",
+											},
+											FieldEvictorScopedMode: {
+												Type:        schema.TypeBool,
+												Optional:    true,
+												Default:     false,
+												Description: "enable/disable scoped mode. By default, Evictor targets all nodes in the cluster. This mode will constrain it to just the nodes which were created by CAST AI.",
+											},
+											FieldEvictorCycleInterval: {
+												Type:             schema.TypeString,
+												Optional:         true,
+												Default:          "1m",
+												Description:      "configure the interval duration between Evictor operations. This property can be used to lower or raise the frequency of the Evictor's find-and-drain operations.",
+												ValidateDiagFunc: validateDuration,
+											},
+											FieldEvictorNodeGracePeriodMinutes: {
+												Type:             schema.TypeInt,
+												Optional:         true,
+												Default:          5,
+												Description:      "configure the node grace period which controls the duration which must pass after a node has been created before Evictor starts considering that node.",
+												ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+											},
+											FieldEvictorPodEvictionFailureBackOffInterval: {
+												Type:             schema.TypeString,
+												Optional:         true,
+												Default:          "5s",
+												Description:      "configure the pod eviction failure back off interval. If pod eviction fails then Evictor will attempt to evict it again after the amount of time specified here.",
+												ValidateDiagFunc: validateDuration,
+											},
+											FieldEvictorIgnorePodDisruptionBudgets: {
+												Type:        schema.TypeBool,
+												Optional:    true,
+												Default:     false,
+												Description: "if enabled then Evictor will attempt to evict pods that have pod disruption budgets configured.",
+											},
+											FieldEvictorSoftTainting: {
+												Type:        schema.TypeBool,
+												Optional:    true,
+												Default:     false,
+												Description: "if enabled then Evictor will use soft tainting (PreferNoSchedule) instead of hard cordoning after eviction.",
+											},
+											FieldEvictorCleanupKarpenterNodes: {
+												Type:        schema.TypeBool,
+												Optional:    true,
+												Default:     false,
+												Description: "if enabled then Evictor will delete Karpenter NodeClaims after draining Karpenter-managed nodes, triggering Karpenter's termination controller for fast instance cleanup.",
 											},
 										},
 									},
@@ -506,6 +552,78 @@ func resourceAutoscaler() *schema.Resource {
 	}
 }
 
+// resourceAutoscalerV0 is the schema of states which didn't model evictor's soft_tainting and cleanup_karpenter_nodes,
+// nor unschedulable pods' disk_gib_to_cpu_ratio.
+func resourceAutoscalerV0() *schema.Resource {
+	s := autoscalerSchema()
+	settings := s[FieldAutoscalerSettings].Elem.(*schema.Resource)
+	unschedulablePods := settings.Schema[FieldUnschedulablePods].Elem.(*schema.Resource)
+	delete(unschedulablePods.Schema, FieldDiskGibToCpuRatio)
+	nodeDownscaler := settings.Schema[FieldNodeDownscaler].Elem.(*schema.Resource)
+	evictor := nodeDownscaler.Schema[FieldEvictor].Elem.(*schema.Resource)
+	delete(evictor.Schema, FieldEvictorSoftTainting)
+	delete(evictor.Schema, FieldEvictorCleanupKarpenterNodes)
+
+	return &schema.Resource{Schema: s}
+}
+
+// autoscalerStateUpgradeV0 moves states which only hold autoscaler_policies_json onto autoscaler_settings, which holds
+// the current policies for such configurations, so that the JSON's changes are planned per attribute. Attributes added
+// to autoscaler_settings already in state are filled with their defaults, so that upgrading the provider doesn't plan
+// them.
+func autoscalerStateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	settings, _ := rawState[FieldAutoscalerSettings].([]interface{})
+	if len(settings) == 0 {
+		policiesJSON, _ := rawState[FieldAutoscalerPolicies].(string)
+		if policiesJSON == "" {
+			policiesJSON, _ = rawState[FieldAutoscalerPoliciesJSON].(string)
+		}
+		if policiesJSON == "" {
+			return rawState, nil
+		}
+
+		var policies sdk.PoliciesV1Policies
+		if err := json.Unmarshal([]byte(policiesJSON), &policies); err != nil {
+			return nil, fmt.Errorf("migrating %s to %s: %w", FieldAutoscalerPoliciesJSON, FieldAutoscalerSettings, err)
+		}
+		rawState[FieldAutoscalerSettings] = []interface{}{flattenAutoscalerPolicies(&policies, false)}
+		return rawState, nil
+	}
+
+	settingsSchema := autoscalerSchema()[FieldAutoscalerSettings].Elem.(*schema.Resource).Schema
+	for i, item := range settings {
+		if block, ok := item.(map[string]interface{}); ok {
+			settings[i] = withSchemaDefaults(block, settingsSchema)
+		}
+	}
+
+	return rawState, nil
+}
+
+// withSchemaDefaults fills attributes missing from a flattened block, and from its nested blocks, with their defaults.
+func withSchemaDefaults(block map[string]interface{}, blockSchema map[string]*schema.Schema) map[string]interface{} {
+	for key, s := range blockSchema {
+		if elem, ok := s.Elem.(*schema.Resource); ok {
+			nested, _ := block[key].([]interface{})
+			for i, item := range nested {
+				if m, ok := item.(map[string]interface{}); ok {
+					nested[i] = withSchemaDefaults(m, elem.Schema)
+				}
+			}
+			continue
+		}
+		if _, ok := block[key]; !ok && s.Default != nil {
+			block[key] = s.Default
+		}
+	}
+
+	return block
+}
+
 func resourceCastaiAutoscalerDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	clusterId := getClusterId(data)
 	if clusterId == "" {
@@ -537,213 +655,351 @@ func autoscalerStateImporter(ctx context.Context, d *schema.ResourceData, meta i
 
 	// Fetch current policies from API
 	client := meta.(*ProviderConfig).api
-	currentPolicies, err := getCurrentPolicies(ctx, client, clusterID)
+	policies, policiesJSON, err := getCurrentAutoscalerPolicies(ctx, client, clusterID)
 	if err != nil {
 		return nil, fmt.Errorf("fetching autoscaler policies for cluster %s: %w", clusterID, err)
 	}
 
-	// Filter out volatile fields that change independently on API side
-	currentPolicies = filterVolatileFields(currentPolicies)
-
 	// Set the computed autoscaler_policies field
-	if err := d.Set(FieldAutoscalerPolicies, string(currentPolicies)); err != nil {
+	if err := d.Set(FieldAutoscalerPolicies, string(policiesJSON)); err != nil {
 		return nil, fmt.Errorf("setting autoscaler_policies: %w", err)
 	}
 
-	// Convert API response to autoscaler_settings structure
-	settings, err := flattenAutoscalerSettings(currentPolicies)
-	if err != nil {
-		return nil, fmt.Errorf("converting policies to autoscaler_settings: %w", err)
-	}
-
-	if settings != nil {
-		if err := d.Set(FieldAutoscalerSettings, settings); err != nil {
-			return nil, fmt.Errorf("setting autoscaler_settings: %w", err)
-		}
+	// Deprecated attributes are left out to encourage configuring them on castai_node_template.
+	if err := d.Set(FieldAutoscalerSettings, []interface{}{flattenAutoscalerPolicies(policies, false)}); err != nil {
+		return nil, fmt.Errorf("setting autoscaler_settings: %w", err)
 	}
 
 	return []*schema.ResourceData{d}, nil
 }
 
-// flattenAutoscalerSettings converts API JSON response to autoscaler_settings Terraform structure.
-// It omits deprecated fields to encourage users to migrate to castai_node_template.
-func flattenAutoscalerSettings(policiesJSON []byte) ([]map[string]interface{}, error) {
-	var apiPolicy map[string]interface{}
-	if err := json.Unmarshal(policiesJSON, &apiPolicy); err != nil {
-		return nil, fmt.Errorf("unmarshaling policies JSON: %w", err)
-	}
-
+// flattenAutoscalerPolicies converts policies returned by the API to the autoscaler_settings block. Only policies set
+// in the API response are included. Deprecated attributes are only included when withDeprecated is set, and
+// disk_gib_to_cpu_ratio never is, as the API ignores it.
+func flattenAutoscalerPolicies(policies *sdk.PoliciesV1Policies, withDeprecated bool) map[string]interface{} {
 	settings := make(map[string]interface{})
-
-	// Top-level fields
-	if v, ok := apiPolicy["enabled"]; ok {
-		settings[FieldEnabled] = v
-	}
-	if v, ok := apiPolicy["isScopedMode"]; ok {
-		settings[FieldIsScopedMode] = v
-	}
-	if v, ok := apiPolicy["nodeTemplatesPartialMatchingEnabled"]; ok {
-		settings[FieldNodeTemplatesPartialMatchingEnabled] = v
-	}
-
-	// Unschedulable Pods (excluding deprecated fields: headroom, headroomSpot, nodeConstraints, customInstancesEnabled)
-	if unschedulablePods, ok := apiPolicy["unschedulablePods"].(map[string]interface{}); ok {
-		up := flattenUnschedulablePods(unschedulablePods)
-		if len(up) > 0 {
-			settings[FieldUnschedulablePods] = []interface{}{up}
-		}
+	flattenValue(settings, FieldEnabled, policies.Enabled)
+	flattenValue(settings, FieldIsScopedMode, policies.IsScopedMode)
+	flattenValue(settings, FieldNodeTemplatesPartialMatchingEnabled, policies.NodeTemplatesPartialMatchingEnabled)
+	flattenBlock(settings, FieldUnschedulablePods, policies.UnschedulablePods, func(up *sdk.PoliciesV1UnschedulablePodsPolicy) map[string]interface{} {
+		return flattenUnschedulablePods(up, withDeprecated)
+	})
+	flattenBlock(settings, FieldClusterLimits, policies.ClusterLimits, flattenClusterLimits)
+	flattenBlock(settings, FieldNodeDownscaler, policies.NodeDownscaler, flattenNodeDownscaler)
+	if withDeprecated {
+		flattenBlock(settings, FieldSpotInstances, policies.SpotInstances, flattenSpotInstances)
 	}
 
-	// Cluster Limits
-	if clusterLimits, ok := apiPolicy["clusterLimits"].(map[string]interface{}); ok {
-		cl := flattenClusterLimits(clusterLimits)
-		if len(cl) > 0 {
-			settings[FieldClusterLimits] = []interface{}{cl}
-		}
-	}
+	return settings
+}
 
-	// Node Downscaler (excluding spot_instances which is deprecated at top level)
-	if nodeDownscaler, ok := apiPolicy["nodeDownscaler"].(map[string]interface{}); ok {
-		nd := flattenNodeDownscaler(nodeDownscaler)
-		if len(nd) > 0 {
-			settings[FieldNodeDownscaler] = []interface{}{nd}
-		}
+func flattenUnschedulablePods(up *sdk.PoliciesV1UnschedulablePodsPolicy, withDeprecated bool) map[string]interface{} {
+	out := make(map[string]interface{})
+	flattenValue(out, FieldEnabled, up.Enabled)
+	flattenBlock(out, FieldPodPinner, up.PodPinner, func(podPinner *sdk.PoliciesV1PodPinner) map[string]interface{} {
+		out := make(map[string]interface{})
+		flattenValue(out, FieldEnabled, podPinner.Enabled)
+		return out
+	})
+	if withDeprecated {
+		flattenBlock(out, FieldHeadroom, up.Headroom, flattenHeadroom)
+		flattenBlock(out, FieldHeadroomSpot, up.HeadroomSpot, flattenHeadroom)
+		flattenBlock(out, FieldNodeConstraints, up.NodeConstraints, flattenNodeConstraints)
+		flattenValue(out, FieldCustomInstancesEnabled, up.CustomInstancesEnabled)
 	}
 
-	// Note: Omitting spotInstances entirely (deprecated)
-
-	return []map[string]interface{}{settings}, nil
+	return out
 }
 
-// flattenUnschedulablePods converts the unschedulablePods API response to Terraform structure.
-// Deprecated fields (headroom, headroomSpot, nodeConstraints, customInstancesEnabled) are omitted.
-// podPinner is included if present in API response so users can track changes made via UI/API.
-func flattenUnschedulablePods(unschedulablePods map[string]interface{}) map[string]interface{} {
-	up := make(map[string]interface{})
+func flattenHeadroom(headroom *sdk.PoliciesV1Headroom) map[string]interface{} {
+	out := make(map[string]interface{})
+	flattenValue(out, FieldEnabled, headroom.Enabled)
+	flattenInt32(out, FieldCPUPercentage, headroom.CpuPercentage)
+	flattenInt32(out, FieldMemoryPercentage, headroom.MemoryPercentage)
+	return out
+}
 
-	if v, ok := unschedulablePods["enabled"]; ok {
-		up[FieldEnabled] = v
-	}
+func flattenNodeConstraints(constraints *sdk.PoliciesV1NodeConstraints) map[string]interface{} {
+	out := make(map[string]interface{})
+	flattenValue(out, FieldEnabled, constraints.Enabled)
+	flattenInt32(out, FieldMinCPUCores, constraints.MinCpuCores)
+	flattenInt32(out, FieldMaxCPUCores, constraints.MaxCpuCores)
+	flattenInt32(out, FieldMinRAMMiB, constraints.MinRamMib)
+	flattenInt32(out, FieldMaxRAMMiB, constraints.MaxRamMib)
+	return out
+}
 
-	// Note: Omitting headroom, headroomSpot, nodeConstraints, customInstancesEnabled (deprecated)
+func flattenClusterLimits(clusterLimits *sdk.PoliciesV1ClusterLimitsPolicy) map[string]interface{} {
+	out := make(map[string]interface{})
+	flattenValue(out, FieldEnabled, clusterLimits.Enabled)
+	flattenBlock(out, FieldCPU, clusterLimits.Cpu, func(cpu *sdk.PoliciesV1ClusterLimitsCpu) map[string]interface{} {
+		out := make(map[string]interface{})
+		flattenInt32(out, FieldMinCores, cpu.MinCores)
+		flattenInt32(out, FieldMaxCores, cpu.MaxCores)
+		return out
+	})
+	return out
+}
 
-	if podPinner, ok := unschedulablePods["podPinner"].(map[string]interface{}); ok {
-		if enabled, ok := podPinner["enabled"].(bool); ok {
-			pp := map[string]interface{}{
-				FieldEnabled: enabled,
-			}
-			up[FieldPodPinner] = []interface{}{pp}
+func flattenSpotInstances(spot *sdk.PoliciesV1SpotInstances) map[string]interface{} {
+	out := make(map[string]interface{})
+	flattenValue(out, FieldEnabled, spot.Enabled)
+	flattenInt32(out, FieldMaxReclaimRate, spot.MaxReclaimRate)
+	flattenValue(out, FieldSpotDiversityEnabled, spot.SpotDiversityEnabled)
+	flattenInt32(out, FieldSpotDiversityPriceIncreaseLimit, spot.SpotDiversityPriceIncreaseLimitPercent)
+	flattenBlock(out, FieldSpotBackups, spot.SpotBackups, func(backups *sdk.PoliciesV1SpotBackups) map[string]interface{} {
+		out := make(map[string]interface{})
+		flattenValue(out, FieldEnabled, backups.Enabled)
+		flattenInt32(out, FieldSpotBackupRestoreRateSeconds, backups.SpotBackupRestoreRateSeconds)
+		return out
+	})
+	flattenBlock(out, FieldSpotInterruptionPredictions, spot.SpotInterruptionPredictions, func(predictions *sdk.PoliciesV1SpotInterruptionPredictions) map[string]interface{} {
+		out := make(map[string]interface{})
+		flattenValue(out, FieldEnabled, predictions.Enabled)
+		if predictions.Type != nil {
+			out[FieldSpotInterruptionPredictionsType] = string(*predictions.Type)
 		}
-	}
+		return out
+	})
+	return out
+}
 
-	return up
+func flattenNodeDownscaler(nodeDownscaler *sdk.PoliciesV1NodeDownscaler) map[string]interface{} {
+	out := make(map[string]interface{})
+	flattenValue(out, FieldEnabled, nodeDownscaler.Enabled)
+	flattenBlock(out, FieldEmptyNodes, nodeDownscaler.EmptyNodes, func(emptyNodes *sdk.PoliciesV1NodeDownscalerEmptyNodes) map[string]interface{} {
+		out := make(map[string]interface{})
+		flattenValue(out, FieldEnabled, emptyNodes.Enabled)
+		flattenInt32(out, FieldDelaySeconds, emptyNodes.DelaySeconds)
+		return out
+	})
+	flattenBlock(out, FieldEvictor, nodeDownscaler.Evictor, flattenEvictor)
+	return out
 }
 
-// flattenClusterLimits converts the clusterLimits API response to Terraform structure.
-func flattenClusterLimits(clusterLimits map[string]interface{}) map[string]interface{} {
-	cl := make(map[string]interface{})
+// flattenEvictor leaves out the evictor's status, which the API computes from the cluster's state.
+func flattenEvictor(evictor *sdk.PoliciesV1Evictor) map[string]interface{} {
+	out := make(map[string]interface{})
+	flattenValue(out, FieldEnabled, evictor.Enabled)
+	flattenValue(out, FieldEvictorDryRun, evictor.DryRun)
+	flattenValue(out, FieldEvictorAggressiveMode, evictor.AggressiveMode)
+	flattenValue(out, FieldEvictorScopedMode, evictor.ScopedMode)
+	flattenValue(out, FieldEvictorCycleInterval, evictor.CycleInterval)
+	flattenInt32(out, FieldEvictorNodeGracePeriodMinutes, evictor.NodeGracePeriodMinutes)
+	flattenValue(out, FieldEvictorPodEvictionFailureBackOffInterval, evictor.PodEvictionFailureBackOffInterval)
+	flattenValue(out, FieldEvictorIgnorePodDisruptionBudgets, evictor.IgnorePodDisruptionBudgets)
+	flattenValue(out, FieldEvictorSoftTainting, evictor.SoftTainting)
+	flattenValue(out, FieldEvictorCleanupKarpenterNodes, evictor.CleanupKarpenterNodes)
+	return out
+}
 
-	if v, ok := clusterLimits["enabled"]; ok {
-		cl[FieldEnabled] = v
+func flattenValue[T any](block map[string]interface{}, key string, value *T) {
+	if value != nil {
+		block[key] = *value
 	}
+}
 
-	if cpu, ok := clusterLimits["cpu"].(map[string]interface{}); ok {
-		cpuMap := make(map[string]interface{})
-		if v, ok := cpu["minCores"]; ok {
-			cpuMap[FieldMinCores] = v
-		}
-		if v, ok := cpu["maxCores"]; ok {
-			cpuMap[FieldMaxCores] = v
-		}
-		if len(cpuMap) > 0 {
-			cl[FieldCPU] = []interface{}{cpuMap}
-		}
+func flattenInt32(block map[string]interface{}, key string, value *int32) {
+	if value != nil {
+		block[key] = int(*value)
 	}
+}
 
-	return cl
+// flattenBlock sets key to a single item list holding the flattened value, unless the value or all of its attributes
+// are unset.
+func flattenBlock[T any](block map[string]interface{}, key string, value *T, flatten func(*T) map[string]interface{}) {
+	if value == nil {
+		return
+	}
+	if nested := flatten(value); len(nested) > 0 {
+		block[key] = []interface{}{nested}
+	}
 }
 
-// flattenNodeDownscaler converts the nodeDownscaler API response to Terraform structure.
-func flattenNodeDownscaler(nodeDownscaler map[string]interface{}) map[string]interface{} {
-	nd := make(map[string]interface{})
+// expandAutoscalerSettings sets the policies defined in the autoscaler_settings block. Policies missing from the
+// block, including blocks which aren't defined, keep their values.
+func expandAutoscalerSettings(settings map[string]interface{}, policies *sdk.PoliciesV1Policies) {
+	expandValue(settings, FieldEnabled, &policies.Enabled)
+	expandValue(settings, FieldIsScopedMode, &policies.IsScopedMode)
+	expandValue(settings, FieldNodeTemplatesPartialMatchingEnabled, &policies.NodeTemplatesPartialMatchingEnabled)
+	expandBlock(settings, FieldUnschedulablePods, &policies.UnschedulablePods, expandUnschedulablePods)
+	expandBlock(settings, FieldClusterLimits, &policies.ClusterLimits, expandClusterLimits)
+	expandBlock(settings, FieldSpotInstances, &policies.SpotInstances, expandSpotInstances)
+	expandBlock(settings, FieldNodeDownscaler, &policies.NodeDownscaler, expandNodeDownscaler)
+}
 
-	if v, ok := nodeDownscaler["enabled"]; ok {
-		nd[FieldEnabled] = v
-	}
+func expandUnschedulablePods(block map[string]interface{}, up *sdk.PoliciesV1UnschedulablePodsPolicy) {
+	expandValue(block, FieldEnabled, &up.Enabled)
+	expandValue(block, FieldCustomInstancesEnabled, &up.CustomInstancesEnabled)
+	expandInt32(block, FieldDiskGibToCpuRatio, &up.DiskGibToCpuRatio)
+	expandBlock(block, FieldHeadroom, &up.Headroom, expandHeadroom)
+	expandBlock(block, FieldHeadroomSpot, &up.HeadroomSpot, expandHeadroom)
+	expandBlock(block, FieldNodeConstraints, &up.NodeConstraints, func(block map[string]interface{}, constraints *sdk.PoliciesV1NodeConstraints) {
+		expandValue(block, FieldEnabled, &constraints.Enabled)
+		expandInt32(block, FieldMinCPUCores, &constraints.MinCpuCores)
+		expandInt32(block, FieldMaxCPUCores, &constraints.MaxCpuCores)
+		expandInt32(block, FieldMinRAMMiB, &constraints.MinRamMib)
+		expandInt32(block, FieldMaxRAMMiB, &constraints.MaxRamMib)
+	})
+	expandBlock(block, FieldPodPinner, &up.PodPinner, func(block map[string]interface{}, podPinner *sdk.PoliciesV1PodPinner) {
+		expandValue(block, FieldEnabled, &podPinner.Enabled)
+	})
+}
 
-	if emptyNodes, ok := nodeDownscaler["emptyNodes"].(map[string]interface{}); ok {
-		en := make(map[string]interface{})
-		if v, ok := emptyNodes["enabled"]; ok {
-			en[FieldEnabled] = v
-		}
-		if v, ok := emptyNodes["delaySeconds"]; ok {
-			en[FieldDelaySeconds] = v
-		}
-		if len(en) > 0 {
-			nd[FieldEmptyNodes] = []interface{}{en}
-		}
-	}
+func expandHeadroom(block map[string]interface{}, headroom *sdk.PoliciesV1Headroom) {
+	expandValue(block, FieldEnabled, &headroom.Enabled)
+	expandInt32(block, FieldCPUPercentage, &headroom.CpuPercentage)
+	expandInt32(block, FieldMemoryPercentage, &headroom.MemoryPercentage)
+}
 
-	if evictor, ok := nodeDownscaler["evictor"].(map[string]interface{}); ok {
-		ev := flattenEvictor(evictor)
-		if len(ev) > 0 {
-			nd[FieldEvictor] = []interface{}{ev}
-		}
-	}
+func expandClusterLimits(block map[string]interface{}, clusterLimits *sdk.PoliciesV1ClusterLimitsPolicy) {
+	expandValue(block, FieldEnabled, &clusterLimits.Enabled)
+	expandBlock(block, FieldCPU, &clusterLimits.Cpu, func(block map[string]interface{}, cpu *sdk.PoliciesV1ClusterLimitsCpu) {
+		expandInt32(block, FieldMinCores, &cpu.MinCores)
+		expandInt32(block, FieldMaxCores, &cpu.MaxCores)
+	})
+}
 
-	return nd
+func expandSpotInstances(block map[string]interface{}, spot *sdk.PoliciesV1SpotInstances) {
+	expandValue(block, FieldEnabled, &spot.Enabled)
+	expandInt32(block, FieldMaxReclaimRate, &spot.MaxReclaimRate)
+	expandValue(block, FieldSpotDiversityEnabled, &spot.SpotDiversityEnabled)
+	expandInt32(block, FieldSpotDiversityPriceIncreaseLimit, &spot.SpotDiversityPriceIncreaseLimitPercent)
+	expandBlock(block, FieldSpotBackups, &spot.SpotBackups, func(block map[string]interface{}, backups *sdk.PoliciesV1SpotBackups) {
+		expandValue(block, FieldEnabled, &backups.Enabled)
+		expandInt32(block, FieldSpotBackupRestoreRateSeconds, &backups.SpotBackupRestoreRateSeconds)
+	})
+	expandBlock(block, FieldSpotInterruptionPredictions, &spot.SpotInterruptionPredictions, func(block map[string]interface{}, predictions *sdk.PoliciesV1SpotInterruptionPredictions) {
+		expandValue(block, FieldEnabled, &predictions.Enabled)
+		if v, ok := block[FieldSpotInterruptionPredictionsType].(string); ok {
+			predictions.Type = lo.ToPtr(sdk.PoliciesV1SpotInterruptionPredictionsType(v))
+		}
+	})
 }
 
-// flattenEvictor converts the evictor API response to Terraform structure.
-func flattenEvictor(evictor map[string]interface{}) map[string]interface{} {
-	ev := make(map[string]interface{})
+func expandNodeDownscaler(block map[string]interface{}, nodeDownscaler *sdk.PoliciesV1NodeDownscaler) {
+	expandValue(block, FieldEnabled, &nodeDownscaler.Enabled)
+	expandBlock(block, FieldEmptyNodes, &nodeDownscaler.EmptyNodes, func(block map[string]interface{}, emptyNodes *sdk.PoliciesV1NodeDownscalerEmptyNodes) {
+		expandValue(block, FieldEnabled, &emptyNodes.Enabled)
+		expandInt32(block, FieldDelaySeconds, &emptyNodes.DelaySeconds)
+	})
+	expandBlock(block, FieldEvictor, &nodeDownscaler.Evictor, func(block map[string]interface{}, evictor *sdk.PoliciesV1Evictor) {
+		expandValue(block, FieldEnabled, &evictor.Enabled)
+		expandValue(block, FieldEvictorDryRun, &evictor.DryRun)
+		expandValue(block, FieldEvictorAggressiveMode, &evictor.AggressiveMode)
+		expandValue(block, FieldEvictorScopedMode, &evictor.ScopedMode)
+		expandValue(block, FieldEvictorCycleInterval, &evictor.CycleInterval)
+		expandInt32(block, FieldEvictorNodeGracePeriodMinutes, &evictor.NodeGracePeriodMinutes)
+		expandValue(block, FieldEvictorPodEvictionFailureBackOffInterval, &evictor.PodEvictionFailureBackOffInterval)
+		expandValue(block, FieldEvictorIgnorePodDisruptionBudgets, &evictor.IgnorePodDisruptionBudgets)
+		expandValue(block, FieldEvictorSoftTainting, &evictor.SoftTainting)
+		expandValue(block, FieldEvictorCleanupKarpenterNodes, &evictor.CleanupKarpenterNodes)
+	})
+}
 
-	if v, ok := evictor["enabled"]; ok {
-		ev[FieldEnabled] = v
-	}
-	if v, ok := evictor["dryRun"]; ok {
-		ev[FieldEvictorDryRun] = v
-	}
-	if v, ok := evictor["aggressiveMode"]; ok {
-		ev[FieldEvictorAggressiveMode] = v
+func expandValue[T any](block map[string]interface{}, key string, target **T) {
+	if v, ok := block[key].(T); ok {
+		*target = &v
 	}
-	if v, ok := evictor["scopedMode"]; ok {
-		ev[FieldEvictorScopedMode] = v
-	}
-	if v, ok := evictor["cycleInterval"]; ok {
-		ev[FieldEvictorCycleInterval] = v
+}
+
+func expandInt32(block map[string]interface{}, key string, target **int32) {
+	if v, ok := block[key].(int); ok {
+		*target = lo.ToPtr(int32(v))
 	}
-	if v, ok := evictor["nodeGracePeriodMinutes"]; ok {
-		ev[FieldEvictorNodeGracePeriodMinutes] = v
+}
+
+// expandBlock expands the single item list under key onto target, allocating it when the API didn't return it.
+func expandBlock[T any](block map[string]interface{}, key string, target **T, expand func(map[string]interface{}, *T)) {
+	nested, _ := block[key].([]interface{})
+	if len(nested) == 0 {
+		return
 	}
-	if v, ok := evictor["podEvictionFailureBackOffInterval"]; ok {
-		ev[FieldEvictorPodEvictionFailureBackOffInterval] = v
+	// Blocks defined without any attributes are read as nil.
+	item, _ := nested[0].(map[string]interface{})
+	if *target == nil {
+		*target = new(T)
 	}
-	if v, ok := evictor["ignorePodDisruptionBudgets"]; ok {
-		ev[FieldEvictorIgnorePodDisruptionBudgets] = v
+	expand(item, *target)
+}
+
+// withoutUnconfigured removes attributes which have no default and aren't set in the configuration at path from block
+// and its nested blocks. Such attributes are synced with the default node template, so their values in state must not
+// overwrite the template's ones.
+func withoutUnconfigured(data types.ResourceProvider, path cty.Path, block map[string]interface{}, blockSchema map[string]*schema.Schema) map[string]interface{} {
+	for key, s := range blockSchema {
+		if elem, ok := s.Elem.(*schema.Resource); ok {
+			nested, _ := block[key].([]interface{})
+			if len(nested) == 1 {
+				if m, ok := nested[0].(map[string]interface{}); ok {
+					withoutUnconfigured(data, path.GetAttr(key).IndexInt(0), m, elem.Schema)
+				}
+			}
+			continue
+		}
+		if s.Default != nil || s.Required {
+			continue
+		}
+		if val, d := data.GetRawConfigAt(path.GetAttr(key)); d.HasError() || val.IsNull() {
+			delete(block, key)
+		}
 	}
 
-	return ev
+	return block
 }
 
-func resourceCastaiAutoscalerDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+func resourceCastaiAutoscalerDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
 	clusterId := getClusterId(d)
 	if clusterId == "" {
 		return nil
 	}
 
-	policies, err := getChangedPolicies(ctx, d, meta, clusterId)
-	if err != nil {
+	if err := validateAutoscalerSettings(d); err != nil {
 		return err
 	}
-	if policies == nil {
+
+	// autoscaler_settings are diffed per attribute, so the merged policies are only known after apply.
+	if _, ok := d.GetOk(FieldAutoscalerPoliciesJSON); !ok && d.NewValueKnown(FieldAutoscalerPoliciesJSON) {
+		if d.HasChange(FieldAutoscalerSettings) {
+			return d.SetNewComputed(FieldAutoscalerPolicies)
+		}
 		return nil
 	}
 
-	// Filter out volatile fields that change independently on API side
-	policies = filterVolatileFields(policies)
+	// For the JSON, autoscaler_settings holds the current policies, so the JSON's changes are planned on the attributes
+	// they change. They are only known after apply when nothing is in state yet.
+	if d.Id() == "" || !d.NewValueKnown(FieldAutoscalerPoliciesJSON) {
+		if err := d.SetNewComputed(FieldAutoscalerSettings); err != nil {
+			return err
+		}
+		return d.SetNewComputed(FieldAutoscalerPolicies)
+	}
+
+	// The planned value of attributes with defaults is the default, as the block isn't configured, so the JSON's
+	// changes are applied to the settings in state.
+	settingsInState, _ := d.GetChange(FieldAutoscalerSettings)
+	settings, _ := settingsInState.([]interface{})
+	if len(settings) == 0 || settings[0] == nil {
+		return d.SetNewComputed(FieldAutoscalerPolicies)
+	}
+	current := settings[0].(map[string]interface{})
 
-	return d.SetNew(FieldAutoscalerPolicies, string(policies))
+	var changes sdk.PoliciesV1Policies
+	if err := json.Unmarshal([]byte(d.Get(FieldAutoscalerPoliciesJSON).(string)), &changes); err != nil {
+		return fmt.Errorf("unmarshaling %s: %w", FieldAutoscalerPoliciesJSON, err)
+	}
+	planned := mergeAutoscalerSettingsBlock(current, flattenAutoscalerPolicies(&changes, false))
+	if !reflect.DeepEqual(current, planned) {
+		if err := d.SetNew(FieldAutoscalerSettings, []interface{}{planned}); err != nil {
+			return err
+		}
+		return d.SetNewComputed(FieldAutoscalerPolicies)
+	}
+	if d.HasChange(FieldAutoscalerPoliciesJSON) {
+		return d.SetNewComputed(FieldAutoscalerPolicies)
+	}
+
+	return nil
 }
 
 func resourceCastaiAutoscalerRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -768,7 +1024,7 @@ func resourceCastaiAutoscalerCreate(ctx context.Context, data *schema.ResourceDa
 	}
 
 	data.SetId(getClusterId(data))
-	return nil
+	return resourceCastaiAutoscalerRead(ctx, data, meta)
 }
 
 func resourceCastaiAutoscalerUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -778,7 +1034,7 @@ func resourceCastaiAutoscalerUpdate(ctx context.Context, data *schema.ResourceDa
 	}
 
 	data.SetId(getClusterId(data))
-	return nil
+	return resourceCastaiAutoscalerRead(ctx, data, meta)
 }
 
 func getCurrentPolicies(ctx context.Context, client sdk.ClientWithResponsesInterface, clusterId string) ([]byte, error) {
@@ -802,6 +1058,21 @@ func getCurrentPolicies(ctx context.Context, client sdk.ClientWithResponsesInter
 	return normalizeJSON(responseBytes)
 }
 
+// getCurrentAutoscalerPolicies returns the cluster's policies along with their JSON.
+func getCurrentAutoscalerPolicies(ctx context.Context, client sdk.ClientWithResponsesInterface, clusterId string) (*sdk.PoliciesV1Policies, []byte, error) {
+	policiesJSON, err := getCurrentPolicies(ctx, client, clusterId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var policies sdk.PoliciesV1Policies
+	if err := json.Unmarshal(policiesJSON, &policies); err != nil {
+		return nil, nil, fmt.Errorf("unmarshaling policies JSON: %w", err)
+	}
+
+	return &policies, policiesJSON, nil
+}
+
 func updateAutoscalerPolicies(ctx context.Context, data *schema.ResourceData, meta interface{}) error {
 	clusterId := getClusterId(data)
 	if clusterId == "" {
@@ -809,26 +1080,59 @@ func updateAutoscalerPolicies(ctx context.Context, data *schema.ResourceData, me
 		return nil
 	}
 
-	// Define the update operation that will be executed with retry logic
-	updatePolicies := func() error {
-		policies, err := getChangedPolicies(ctx, data, meta, clusterId)
+	policiesJSON := data.Get(FieldAutoscalerPoliciesJSON).(string)
+	settings := configuredAutoscalerSettings(data)
+	if policiesJSON == "" && settings == nil {
+		log.Printf("[DEBUG] policies not provided. Skipping autoscaler policies changes")
+		return nil
+	}
+
+	// Policies are changed on top of the current ones, which carry defaultNodeTemplateVersion, so the API rejects the
+	// upsert when they were changed in between and the cycle is retried.
+	client := meta.(*ProviderConfig).api
+	return retryOnPoliciesVersionConflict(ctx, clusterId, func() error {
+		policies, _, err := getCurrentAutoscalerPolicies(ctx, client, clusterId)
 		if err != nil {
-			return err
+			return fmt.Errorf("failed to get policies from API: %w", err)
 		}
 
-		if policies == nil {
-			log.Printf("[DEBUG] changed policies json not calculated. Skipping autoscaler policies changes")
-			return nil
+		if policiesJSON != "" {
+			if err := json.Unmarshal([]byte(policiesJSON), policies); err != nil {
+				return fmt.Errorf("applying %s: %w", FieldAutoscalerPoliciesJSON, err)
+			}
+		} else {
+			expandAutoscalerSettings(settings, policies)
 		}
 
-		changedPoliciesJSON := string(policies)
-		if changedPoliciesJSON == "" {
-			log.Printf("[DEBUG] changed policies json not found. Skipping autoscaler policies changes")
-			return nil
-		}
+		return upsertAutoscalerPolicies(ctx, meta, clusterId, policies)
+	})
+}
 
-		return upsertPolicies(ctx, meta, clusterId, changedPoliciesJSON)
+// configuredAutoscalerSettings returns the autoscaler_settings block to apply, or nil when it isn't set.
+func configuredAutoscalerSettings(data *schema.ResourceData) map[string]interface{} {
+	settings, _ := data.Get(FieldAutoscalerSettings).([]interface{})
+	if len(settings) == 0 {
+		return nil
 	}
+	block, _ := settings[0].(map[string]interface{})
+	if block == nil {
+		block = make(map[string]interface{})
+	}
+
+	settingsSchema := autoscalerSchema()[FieldAutoscalerSettings].Elem.(*schema.Resource).Schema
+	return withoutUnconfigured(data, cty.GetAttrPath(FieldAutoscalerSettings).IndexInt(0), block, settingsSchema)
+}
+
+// autoscalerPoliciesLocks serializes read-modify-write cycles of a cluster's autoscaler policies within the provider,
+// as castai_autoscaler and the autoscaler fragment resources of the same cluster are applied in parallel.
+var autoscalerPoliciesLocks sync.Map
+
+// retryOnPoliciesVersionConflict runs update, which reads, modifies and upserts the cluster's policies, retrying it
+// while the API rejects the policies for having been changed concurrently.
+func retryOnPoliciesVersionConflict(ctx context.Context, clusterId string, update func() error) error {
+	lock, _ := autoscalerPoliciesLocks.LoadOrStore(clusterId, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
 
 	// Exponential backoff configuration
 	backoff := wait.Backoff{
@@ -840,7 +1144,7 @@ func updateAutoscalerPolicies(ctx context.Context, data *schema.ResourceData, me
 	}
 
 	retryErr := wait.ExponentialBackoffWithContext(ctx, backoff, func(ctx context.Context) (done bool, err error) {
-		err = updatePolicies()
+		err = update()
 		if err == nil {
 			return true, nil // Success - stop retrying
 		}
@@ -864,6 +1168,15 @@ func updateAutoscalerPolicies(ctx context.Context, data *schema.ResourceData, me
 	return nil
 }
 
+func upsertAutoscalerPolicies(ctx context.Context, meta interface{}, clusterId string, policies *sdk.PoliciesV1Policies) error {
+	policiesJSON, err := json.Marshal(policies)
+	if err != nil {
+		return fmt.Errorf("marshaling policies: %w", err)
+	}
+
+	return upsertPolicies(ctx, meta, clusterId, string(policiesJSON))
+}
+
 func upsertPolicies(ctx context.Context, meta interface{}, clusterId string, changedPoliciesJSON string) error {
 	client := meta.(*ProviderConfig).api
 
@@ -895,116 +1208,76 @@ func readAutoscalerPolicies(ctx context.Context, data *schema.ResourceData, meta
 	}
 
 	client := meta.(*ProviderConfig).api
-	currentPolicies, err := getCurrentPolicies(ctx, client, clusterId)
+	policies, policiesJSON, err := getCurrentAutoscalerPolicies(ctx, client, clusterId)
 	if err != nil {
 		return err
 	}
 
-	// Filter out volatile fields that change independently on API side
-	currentPolicies = filterVolatileFields(currentPolicies)
-
-	err = data.Set(FieldAutoscalerPolicies, string(currentPolicies))
+	err = data.Set(FieldAutoscalerPolicies, string(policiesJSON))
 	if err != nil {
 		log.Printf("[ERROR] Failed to set field: %v", err)
 		return err
 	}
 
-	return nil
-}
-
-func getClusterId(data types.ResourceProvider) string {
-	value, found := data.GetOk(FieldClusterId)
-	if !found {
-		return ""
+	if err := data.Set(FieldAutoscalerSettings, refreshAutoscalerSettings(data, policies)); err != nil {
+		log.Printf("[ERROR] Failed to set field: %v", err)
+		return err
 	}
 
-	return value.(string)
+	return nil
 }
 
-// filterVolatileFields removes API-computed fields that change independently and cause unnecessary drift.
-// This includes fields that are bidirectionally synced with node templates.
-func filterVolatileFields(policiesJSON []byte) []byte {
-	var policies map[string]interface{}
-	if err := json.Unmarshal(policiesJSON, &policies); err != nil {
-		return policiesJSON // return as-is if unmarshal fails
+// refreshAutoscalerSettings updates autoscaler_settings with the policies returned by the API, so that drift is
+// reported per attribute. Configured settings are refreshed in the blocks which are already in state, deprecated ones
+// included. For autoscaler_policies_json, and when nothing is in state yet, the block holds all current policies except
+// for the deprecated ones.
+func refreshAutoscalerSettings(data *schema.ResourceData, policies *sdk.PoliciesV1Policies) []interface{} {
+	settings, _ := data.Get(FieldAutoscalerSettings).([]interface{})
+	if len(settings) == 0 || settings[0] == nil || data.Get(FieldAutoscalerPoliciesJSON).(string) != "" {
+		return []interface{}{flattenAutoscalerPolicies(policies, false)}
 	}
 
-	// Remove fields that change due to policy↔node template sync
-	delete(policies, "defaultNodeTemplateVersion")
-	delete(policies, "spotInstances") // synced with node template spot settings
+	return []interface{}{mergeAutoscalerSettingsBlock(settings[0].(map[string]interface{}), flattenAutoscalerPolicies(policies, true))}
+}
 
-	// Remove nested deprecated fields that sync with node templates
-	if up, ok := policies["unschedulablePods"].(map[string]interface{}); ok {
-		delete(up, "nodeConstraints")        // synced with node template min/max CPU/RAM
-		delete(up, "customInstancesEnabled") // synced with node template
+func mergeAutoscalerSettingsBlock(current, remote map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(current))
+	for key, value := range current {
+		out[key] = value
 
-		// Remove API-computed status fields that change based on cluster state
-		if podPinner, ok := up["podPinner"].(map[string]interface{}); ok {
-			delete(podPinner, "status")
+		remoteValue, ok := remote[key]
+		if !ok || remoteValue == nil {
+			continue
 		}
-	}
 
-	// Remove API-computed status field from evictor
-	if nd, ok := policies["nodeDownscaler"].(map[string]interface{}); ok {
-		if evictor, ok := nd["evictor"].(map[string]interface{}); ok {
-			delete(evictor, "status")
+		nested, isBlock := value.([]interface{})
+		if !isBlock {
+			out[key] = remoteValue
+			continue
 		}
-	}
-
-	filtered, err := json.Marshal(policies)
-	if err != nil {
-		return policiesJSON
-	}
-	return filtered
-}
-
-func getChangedPolicies(ctx context.Context, data types.ResourceProvider, meta interface{}, clusterId string) ([]byte, error) {
-	policyChangesJSON, isPoliciesJSONExist := data.GetOk(FieldAutoscalerPoliciesJSON)
-	_, isPoliciesSettingsExist := data.GetOk(FieldAutoscalerSettings)
-
-	var policyChanges []byte
-
-	if isPoliciesSettingsExist {
-		policy, err := toAutoscalerPolicy(data)
-		if err != nil {
-			return nil, fmt.Errorf("failed to deserialize policy definitions: %v", err)
+		remoteNested, _ := remoteValue.([]interface{})
+		if len(nested) != 1 || len(remoteNested) != 1 {
+			continue
 		}
-
-		adjustPolicyForDrift(data, policy)
-
-		data, err := json.Marshal(policy)
-		if err != nil {
-			return nil, fmt.Errorf("failed to serialize policy definition: %v", err)
+		currentBlock, ok := nested[0].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if remoteBlock, ok := remoteNested[0].(map[string]interface{}); ok {
+			out[key] = []interface{}{mergeAutoscalerSettingsBlock(currentBlock, remoteBlock)}
 		}
-
-		policyChanges = data
-	} else if isPoliciesJSONExist {
-		policyChanges = []byte(policyChangesJSON.(string))
-	} else {
-		log.Printf("[DEBUG] policies json not provided. Skipping autoscaler policies changes")
-		return nil, nil
-	}
-
-	if !json.Valid(policyChanges) {
-		log.Printf("[WARN] policies JSON invalid: %v", string(policyChanges))
-		return nil, fmt.Errorf("policies JSON invalid")
 	}
 
-	client := meta.(*ProviderConfig).api
-
-	currentPolicies, err := getCurrentPolicies(ctx, client, clusterId)
-	if err != nil {
-		log.Printf("[WARN] Getting current policies: %v", err)
-		return nil, fmt.Errorf("failed to get policies from API: %v", err)
-	}
+	return out
+}
 
-	policies, err := jsonpatch.MergePatch(currentPolicies, policyChanges)
-	if err != nil {
-		log.Printf("[WARN] Failed to merge policy changes: %v", err)
-		return nil, fmt.Errorf("failed to merge policies: %v", err)
+func getClusterId(data types.ResourceProvider) string {
+	value, found := data.GetOk(FieldClusterId)
+	if !found {
+		return ""
 	}
 
-	return normalizeJSON(policies)
+	return value.(string)
 }
 
 func validateAutoscalerPolicyJSON() schema.SchemaValidateDiagFunc {
@@ -1044,66 +1317,39 @@ func createValidationError(field, value string) error {
 		"Policy:\n%v", field, value)
 }
 
-// adjustPolicyForDrift removes values of certain fields which, if retained, might result in constant plan changes.
-// Changes to legacy autoscaler policies and default node template sync to one another, and if values of certain fields
-// are not equal, then they'll keep producing plan changes. Originally, some of these fields had default values
-// and their values are now stored in pre-existing states, so we cannot use the policies stored in state as-is. We null
-// those fields in case they are not specified in the configuration.
-func adjustPolicyForDrift(data types.ResourceProvider, policy *types.AutoscalerPolicy) {
-	if policy == nil {
-		return
+// validateAutoscalerSettings checks autoscaler_settings attributes which are only valid in relation to one another.
+func validateAutoscalerSettings(data types.ResourceProvider) error {
+	settings, ok := data.GetOk(FieldAutoscalerSettings)
+	if !ok {
+		return nil
 	}
-
-	// Handle deprecated spot_instances field
-	val, d := data.GetRawConfigAt(cty.GetAttrPath(FieldAutoscalerSettings).IndexInt(0).GetAttr(FieldSpotInstances))
-	if !d.HasError() && val.IsNull() {
-		policy.SpotInstances = nil
+	block, ok := settings.([]interface{})[0].(map[string]interface{})
+	if !ok {
+		return nil
 	}
 
-	if policy.UnschedulablePods != nil {
-		unschedulablePodsPath := cty.GetAttrPath(FieldAutoscalerSettings).IndexInt(0).GetAttr(FieldUnschedulablePods).IndexInt(0)
-
-		// Handle custom_instances_enabled (deprecated)
-		val, d := data.GetRawConfigAt(unschedulablePodsPath.GetAttr(FieldCustomInstancesEnabled))
-		if !d.HasError() && val.IsNull() {
-			policy.UnschedulablePods.CustomInstances = nil
-		}
-
-		// Handle headroom (deprecated)
-		val, d = data.GetRawConfigAt(unschedulablePodsPath.GetAttr(FieldHeadroom))
-		if !d.HasError() && val.IsNull() {
-			policy.UnschedulablePods.Headroom = nil
-		}
+	var policies sdk.PoliciesV1Policies
+	expandAutoscalerSettings(block, &policies)
 
-		// Handle headroom_spot (deprecated)
-		val, d = data.GetRawConfigAt(unschedulablePodsPath.GetAttr(FieldHeadroomSpot))
-		if !d.HasError() && val.IsNull() {
-			policy.UnschedulablePods.HeadroomSpot = nil
+	if policies.UnschedulablePods != nil && policies.UnschedulablePods.NodeConstraints != nil {
+		constraints := policies.UnschedulablePods.NodeConstraints
+		if lo.FromPtr(constraints.MinCpuCores) > lo.FromPtr(constraints.MaxCpuCores) {
+			return fmt.Errorf("%s: %s (%d) must not be greater than %s (%d)", FieldNodeConstraints,
+				FieldMinCPUCores, lo.FromPtr(constraints.MinCpuCores), FieldMaxCPUCores, lo.FromPtr(constraints.MaxCpuCores))
 		}
-
-		// Handle node_constraints (deprecated)
-		val, d = data.GetRawConfigAt(unschedulablePodsPath.GetAttr(FieldNodeConstraints))
-		if !d.HasError() && val.IsNull() {
-			policy.UnschedulablePods.NodeConstraints = nil
+		if lo.FromPtr(constraints.MinRamMib) > lo.FromPtr(constraints.MaxRamMib) {
+			return fmt.Errorf("%s: %s (%d) must not be greater than %s (%d)", FieldNodeConstraints,
+				FieldMinRAMMiB, lo.FromPtr(constraints.MinRamMib), FieldMaxRAMMiB, lo.FromPtr(constraints.MaxRamMib))
 		}
 	}
-}
-
-// toAutoscalerPolicy converts FieldAutoscalerSettings to types.AutoscalerPolicy for given data.
-func toAutoscalerPolicy(data types.ResourceProvider) (*types.AutoscalerPolicy, error) {
-	out, ok := extractNestedValues(data, FieldAutoscalerSettings, true, true)
-	if !ok {
-		return nil, nil
-	}
-
-	var policy types.AutoscalerPolicy
 
-	// This allows us to decode the map into a struct with weak type conversions
-	// like "true" string into bool true or "1" string into int 1.
-	err := mapstructure.WeakDecode(out, &policy)
-	if err != nil {
-		return nil, err
+	if policies.ClusterLimits != nil && policies.ClusterLimits.Cpu != nil {
+		cpu := policies.ClusterLimits.Cpu
+		if lo.FromPtr(cpu.MinCores) > lo.FromPtr(cpu.MaxCores) {
+			return fmt.Errorf("%s: %s (%d) must not be greater than %s (%d)", FieldClusterLimits,
+				FieldMinCores, lo.FromPtr(cpu.MinCores), FieldMaxCores, lo.FromPtr(cpu.MaxCores))
+		}
 	}
 
-	return &policy, nil
+	return nil
 }
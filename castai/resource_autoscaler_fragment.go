@@ -0,0 +1,177 @@
+package castai
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+)
+
+// autoscalerFragment describes a resource which manages a single subtree of the cluster's autoscaler policies,
+// so that the subtrees can be owned by different teams without fighting over one castai_autoscaler resource.
+type autoscalerFragment struct {
+	// field is the name of the subtree's block in castai_autoscaler autoscaler_settings.
+	field string
+}
+
+func resourceAutoscalerNodeDownscaler() *schema.Resource {
+	return resourceAutoscalerFragment(autoscalerFragment{field: FieldNodeDownscaler},
+		"CAST AI resource to manage the node downscaler policy of a cluster, including empty nodes and Evictor settings.")
+}
+
+func resourceAutoscalerSpotInstances() *schema.Resource {
+	return resourceAutoscalerFragment(autoscalerFragment{field: FieldSpotInstances},
+		"CAST AI resource to manage the spot instances policy of a cluster. The policy is synced with the default node "+
+			"template, so changes made there are refreshed and attributes missing from the configuration keep their synced values.")
+}
+
+func resourceAutoscalerUnschedulablePods() *schema.Resource {
+	return resourceAutoscalerFragment(autoscalerFragment{field: FieldUnschedulablePods},
+		"CAST AI resource to manage the unschedulable pods policy of a cluster.")
+}
+
+func resourceAutoscalerClusterLimits() *schema.Resource {
+	return resourceAutoscalerFragment(autoscalerFragment{field: FieldClusterLimits},
+		"CAST AI resource to manage the cluster limits policy of a cluster.")
+}
+
+func resourceAutoscalerFragment(fragment autoscalerFragment, description string) *schema.Resource {
+	fragmentSchema := fragment.schema()
+
+	return &schema.Resource{
+		ReadContext:   fragment.read,
+		CreateContext: fragment.upsert,
+		UpdateContext: fragment.upsert,
+		DeleteContext: fragment.delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: fragment.importState,
+		},
+		Description: description + fmt.Sprintf(" Other policies are left untouched. Don't combine it with `%s` configured "+
+			"in `castai_autoscaler` of the same cluster. Destroying the resource leaves the policy as it is.", fragment.field),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+		},
+		Schema: fragmentSchema,
+	}
+}
+
+// schema returns the attributes of the subtree's block in castai_autoscaler autoscaler_settings, keyed by cluster.
+func (f autoscalerFragment) schema() map[string]*schema.Schema {
+	settings := autoscalerSchema()[FieldAutoscalerSettings].Elem.(*schema.Resource).Schema
+	fragmentSchema := settings[f.field].Elem.(*schema.Resource).Schema
+	fragmentSchema[FieldClusterId] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IsUUID),
+		Description:      "CAST AI cluster id.",
+	}
+
+	return fragmentSchema
+}
+
+func (f autoscalerFragment) read(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clusterId := getClusterId(data)
+	client := meta.(*ProviderConfig).api
+
+	policies, _, err := getCurrentAutoscalerPolicies(ctx, client, clusterId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	current := make(map[string]interface{})
+	for key := range f.schema() {
+		if key != FieldClusterId {
+			current[key] = data.Get(key)
+		}
+	}
+	for key, value := range mergeAutoscalerSettingsBlock(current, f.flatten(policies, true)) {
+		if err := data.Set(key, value); err != nil {
+			return diag.FromErr(fmt.Errorf("setting %s: %w", key, err))
+		}
+	}
+
+	return nil
+}
+
+func (f autoscalerFragment) upsert(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clusterId := getClusterId(data)
+	client := meta.(*ProviderConfig).api
+
+	fragmentSchema := f.schema()
+	values := make(map[string]interface{})
+	for key := range fragmentSchema {
+		if key != FieldClusterId {
+			values[key] = data.Get(key)
+		}
+	}
+	settings := map[string]interface{}{
+		f.field: []interface{}{withoutUnconfigured(data, cty.Path{}, values, fragmentSchema)},
+	}
+
+	// The subtree is changed on top of the current policies, which carry defaultNodeTemplateVersion, so the API
+	// rejects the upsert when the policies were changed in between and the cycle is retried.
+	err := retryOnPoliciesVersionConflict(ctx, clusterId, func() error {
+		policies, _, err := getCurrentAutoscalerPolicies(ctx, client, clusterId)
+		if err != nil {
+			return fmt.Errorf("failed to get policies from API: %w", err)
+		}
+		expandAutoscalerSettings(settings, policies)
+		return upsertAutoscalerPolicies(ctx, meta, clusterId, policies)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(clusterId)
+	return f.read(ctx, data, meta)
+}
+
+func (f autoscalerFragment) delete(_ context.Context, data *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Leaving %s policy of cluster %s as it is, removing it from state", f.field, getClusterId(data))
+	return nil
+}
+
+func (f autoscalerFragment) importState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	clusterID := d.Id()
+	if _, err := uuid.Parse(clusterID); err != nil {
+		return nil, fmt.Errorf("expected cluster_id to be a valid UUID, got: %q", clusterID)
+	}
+	if err := d.Set(FieldClusterId, clusterID); err != nil {
+		return nil, fmt.Errorf("setting cluster_id: %w", err)
+	}
+
+	client := meta.(*ProviderConfig).api
+	policies, _, err := getCurrentAutoscalerPolicies(ctx, client, clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching autoscaler policies for cluster %s: %w", clusterID, err)
+	}
+	for key, value := range f.flatten(policies, false) {
+		if err := d.Set(key, value); err != nil {
+			return nil, fmt.Errorf("setting %s: %w", key, err)
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// flatten returns the attributes of the fragment's subtree of the policies, see flattenAutoscalerPolicies. The spot
+// instances block is deprecated as a whole, so its fragment always includes it.
+func (f autoscalerFragment) flatten(policies *sdk.PoliciesV1Policies, withDeprecated bool) map[string]interface{} {
+	withDeprecated = withDeprecated || f.field == FieldSpotInstances
+	blocks, _ := flattenAutoscalerPolicies(policies, withDeprecated)[f.field].([]interface{})
+	if len(blocks) == 0 {
+		return map[string]interface{}{}
+	}
+	return blocks[0].(map[string]interface{})
+}
@@ -0,0 +1,202 @@
+package castai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+	mock_sdk "github.com/castai/terraform-provider-castai/castai/sdk/mock"
+)
+
+const autoscalerFragmentPolicies = `{
+	"enabled": true,
+	"defaultNodeTemplateVersion": "7",
+	"clusterLimits": {"enabled": true, "cpu": {"minCores": 1, "maxCores": 100}},
+	"nodeDownscaler": {
+		"enabled": true,
+		"emptyNodes": {"enabled": true, "delaySeconds": 600},
+		"evictor": {"enabled": false, "cycleInterval": "1m", "status": "Running"}
+	}
+}`
+
+func TestAutoscalerNodeDownscaler_CreateContext(t *testing.T) {
+	t.Parallel()
+
+	clusterID := "b6bfc074-a267-400f-b8f1-db0850c369c1"
+	raw := map[string]any{
+		FieldClusterId: clusterID,
+		FieldEmptyNodes: []any{map[string]any{
+			FieldEnabled:      true,
+			FieldDelaySeconds: 120,
+		}},
+	}
+
+	t.Run("should patch only node downscaler policy", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient, provider := newAutoscalerFragmentMocks(t)
+
+		var upserted map[string]any
+		gomock.InOrder(
+			mockClient.EXPECT().PoliciesAPIGetClusterPolicies(gomock.Any(), clusterID, gomock.Any()).
+				Return(autoscalerPoliciesResponse(http.StatusOK, autoscalerFragmentPolicies), nil),
+			mockClient.EXPECT().PoliciesAPIUpsertClusterPoliciesWithBody(gomock.Any(), clusterID, "application/json", gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, _ string, body io.Reader, _ ...sdk.RequestEditorFn) (*http.Response, error) {
+					r.NoError(json.NewDecoder(body).Decode(&upserted))
+					return autoscalerPoliciesResponse(http.StatusOK, `{}`), nil
+				}),
+			mockClient.EXPECT().PoliciesAPIGetClusterPolicies(gomock.Any(), clusterID, gomock.Any()).
+				Return(autoscalerPoliciesResponse(http.StatusOK, autoscalerFragmentPolicies), nil),
+		)
+
+		resource := resourceAutoscalerNodeDownscaler()
+		data := schema.TestResourceDataRaw(t, resource.Schema, raw)
+
+		result := resource.CreateContext(context.Background(), data, provider)
+		r.Nil(result)
+		r.Equal(clusterID, data.Id())
+		r.Equal("7", upserted["defaultNodeTemplateVersion"])
+		r.Equal(map[string]any{"enabled": true, "cpu": map[string]any{"minCores": float64(1), "maxCores": float64(100)}}, upserted["clusterLimits"])
+		emptyNodes := upserted["nodeDownscaler"].(map[string]any)["emptyNodes"].(map[string]any)
+		r.Equal(float64(120), emptyNodes["delaySeconds"])
+	})
+
+	t.Run("should retry when policies were changed concurrently", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient, provider := newAutoscalerFragmentMocks(t)
+
+		gomock.InOrder(
+			mockClient.EXPECT().PoliciesAPIGetClusterPolicies(gomock.Any(), clusterID, gomock.Any()).
+				Return(autoscalerPoliciesResponse(http.StatusOK, autoscalerFragmentPolicies), nil),
+			mockClient.EXPECT().PoliciesAPIUpsertClusterPoliciesWithBody(gomock.Any(), clusterID, "application/json", gomock.Any()).
+				Return(autoscalerPoliciesResponse(http.StatusBadRequest, `{"message":"default node template has changed, refetch the policies"}`), nil),
+			mockClient.EXPECT().PoliciesAPIGetClusterPolicies(gomock.Any(), clusterID, gomock.Any()).
+				Return(autoscalerPoliciesResponse(http.StatusOK, autoscalerFragmentPolicies), nil),
+			mockClient.EXPECT().PoliciesAPIUpsertClusterPoliciesWithBody(gomock.Any(), clusterID, "application/json", gomock.Any()).
+				Return(autoscalerPoliciesResponse(http.StatusOK, `{}`), nil),
+			mockClient.EXPECT().PoliciesAPIGetClusterPolicies(gomock.Any(), clusterID, gomock.Any()).
+				Return(autoscalerPoliciesResponse(http.StatusOK, autoscalerFragmentPolicies), nil),
+		)
+
+		resource := resourceAutoscalerNodeDownscaler()
+		data := schema.TestResourceDataRaw(t, resource.Schema, raw)
+
+		result := resource.CreateContext(context.Background(), data, provider)
+		r.Nil(result)
+	})
+}
+
+func TestAutoscalerNodeDownscaler_ReadContext(t *testing.T) {
+	t.Parallel()
+	r := require.New(t)
+	mockClient, provider := newAutoscalerFragmentMocks(t)
+	clusterID := "b6bfc074-a267-400f-b8f1-db0850c369c2"
+
+	mockClient.EXPECT().PoliciesAPIGetClusterPolicies(gomock.Any(), clusterID, gomock.Any()).
+		Return(autoscalerPoliciesResponse(http.StatusOK, autoscalerFragmentPolicies), nil)
+
+	resource := resourceAutoscalerNodeDownscaler()
+	state := terraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{
+		FieldClusterId: cty.StringVal(clusterID),
+		FieldEnabled:   cty.BoolVal(true),
+		FieldEmptyNodes: cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			FieldEnabled:      cty.BoolVal(true),
+			FieldDelaySeconds: cty.NumberIntVal(120),
+		})}),
+	}), 0)
+	state.ID = clusterID
+	data := resource.Data(state)
+
+	result := resource.ReadContext(context.Background(), data, provider)
+	r.Nil(result)
+	r.Equal(600, data.Get(FieldEmptyNodes+".0."+FieldDelaySeconds))
+	// Blocks which are not managed aren't added to state.
+	r.Empty(data.Get(FieldEvictor))
+}
+
+func TestAutoscalerSpotInstances_ReadContext(t *testing.T) {
+	t.Parallel()
+	r := require.New(t)
+	mockClient, provider := newAutoscalerFragmentMocks(t)
+	clusterID := "b6bfc074-a267-400f-b8f1-db0850c369c4"
+
+	// The policy is synced from the default node template, which was changed outside of Terraform.
+	policies := `{"enabled":true,"spotInstances":{"enabled":false,"spotDiversityEnabled":true,"spotDiversityPriceIncreaseLimitPercent":30,"spotBackups":{"enabled":true,"spotBackupRestoreRateSeconds":900}}}`
+	mockClient.EXPECT().PoliciesAPIGetClusterPolicies(gomock.Any(), clusterID, gomock.Any()).
+		DoAndReturn(func(context.Context, string, ...sdk.RequestEditorFn) (*http.Response, error) {
+			return autoscalerPoliciesResponse(http.StatusOK, policies), nil
+		}).Times(2)
+
+	resource := resourceAutoscalerSpotInstances()
+	state := terraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{
+		FieldClusterId: cty.StringVal(clusterID),
+		FieldEnabled:   cty.BoolVal(true),
+		FieldSpotBackups: cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			FieldEnabled:                      cty.BoolVal(true),
+			FieldSpotBackupRestoreRateSeconds: cty.NumberIntVal(1800),
+		})}),
+	}), 0)
+	state.ID = clusterID
+	data := resource.Data(state)
+
+	result := resource.ReadContext(context.Background(), data, provider)
+	r.Nil(result)
+	r.Equal(false, data.Get(FieldEnabled))
+	r.Equal(30, data.Get(FieldSpotDiversityPriceIncreaseLimit))
+	r.Equal(900, data.Get(FieldSpotBackups+".0."+FieldSpotBackupRestoreRateSeconds))
+
+	imported, err := resource.Importer.StateContext(context.Background(), resource.Data(&terraform.InstanceState{ID: clusterID}), provider)
+	r.NoError(err)
+	r.Equal(true, imported[0].Get(FieldSpotDiversityEnabled))
+	r.Equal(900, imported[0].Get(FieldSpotBackups+".0."+FieldSpotBackupRestoreRateSeconds))
+}
+
+func TestAutoscalerFragment_Importer(t *testing.T) {
+	t.Parallel()
+	r := require.New(t)
+	mockClient, provider := newAutoscalerFragmentMocks(t)
+	clusterID := "b6bfc074-a267-400f-b8f1-db0850c369c3"
+
+	mockClient.EXPECT().PoliciesAPIGetClusterPolicies(gomock.Any(), clusterID, gomock.Any()).
+		Return(autoscalerPoliciesResponse(http.StatusOK, autoscalerFragmentPolicies), nil)
+
+	resource := resourceAutoscalerClusterLimits()
+	data := resource.Data(&terraform.InstanceState{ID: clusterID})
+
+	result, err := resource.Importer.StateContext(context.Background(), data, provider)
+	r.NoError(err)
+	r.Len(result, 1)
+	r.Equal(clusterID, result[0].Get(FieldClusterId))
+	r.Equal(100, result[0].Get(FieldCPU+".0."+FieldMaxCores))
+
+	_, err = resource.Importer.StateContext(context.Background(), resource.Data(&terraform.InstanceState{ID: "invalid"}), provider)
+	r.ErrorContains(err, "expected cluster_id to be a valid UUID")
+}
+
+func newAutoscalerFragmentMocks(t *testing.T) (*mock_sdk.MockClientInterface, *ProviderConfig) {
+	mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+	return mockClient, &ProviderConfig{
+		api: &sdk.ClientWithResponses{
+			ClientInterface: mockClient,
+		},
+	}
+}
+
+func autoscalerPoliciesResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+		Header:     map[string][]string{"Content-Type": {"json"}},
+	}
+}
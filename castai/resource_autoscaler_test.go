@@ -23,7 +23,6 @@ import (
 
 	"github.com/castai/terraform-provider-castai/castai/sdk"
 	mock_sdk "github.com/castai/terraform-provider-castai/castai/sdk/mock"
-	"github.com/castai/terraform-provider-castai/castai/types"
 )
 
 func TestAutoscalerResource_PoliciesUpdateAction(t *testing.T) {
@@ -149,21 +148,17 @@ func TestAutoscalerResource_PoliciesUpdateAction(t *testing.T) {
 	state := terraform.NewInstanceStateShimmedFromValue(val, 0)
 	data := resource.Data(state)
 
-	body := io.NopCloser(bytes.NewReader([]byte(currentPolicies)))
-	response := &http.Response{StatusCode: 200, Body: body}
-
 	policiesUpdated := false
 
-	mockClient.EXPECT().PoliciesAPIGetClusterPolicies(gomock.Any(), clusterId, gomock.Any()).Return(response, nil).Times(1)
+	// Policies are read once to apply the JSON onto them, and once more to refresh the state after the upsert.
+	mockClient.EXPECT().PoliciesAPIGetClusterPolicies(gomock.Any(), clusterId, gomock.Any()).
+		DoAndReturn(func(context.Context, string, ...sdk.RequestEditorFn) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(currentPolicies)))}, nil
+		}).Times(2)
 	mockClient.EXPECT().PoliciesAPIUpsertClusterPoliciesWithBody(gomock.Any(), clusterId, "application/json", gomock.Any()).
 		DoAndReturn(func(ctx context.Context, clusterId string, contentType string, body io.Reader) (*http.Response, error) {
 			got, _ := io.ReadAll(body)
-			expected := []byte(updatedPolicies)
-
-			eq, err := JSONBytesEqual(got, expected)
-			r.NoError(err)
-			r.True(eq, fmt.Sprintf("got:      %v\n"+
-				"expected: %v\n", string(got), string(expected)))
+			requirePoliciesEqual(r, updatedPolicies, got)
 
 			policiesUpdated = true
 
@@ -445,38 +440,8 @@ func TestAutoscalerResource_ReadPoliciesAction(t *testing.T) {
 		    }
 		}`
 
-	// Expected output has volatile/synced fields filtered out (nodeConstraints removed)
-	expectedPoliciesBytes, err := normalizeJSON([]byte(`{
-		    "enabled": true,
-		    "isScopedMode": false,
-		    "unschedulablePods": {
-		        "enabled": true,
-		        "headroom": {
-		            "cpuPercentage": 10,
-		            "memoryPercentage": 10,
-		            "enabled": true
-		        },
-		        "headroomSpot": {
-		            "cpuPercentage": 10,
-		            "memoryPercentage": 10,
-		            "enabled": true
-		        },
-		        "diskGibToCpuRatio": 25
-		    },
-		    "clusterLimits": {
-		        "enabled": false,
-		        "cpu": {
-		            "minCores": 1,
-		            "maxCores": 20
-		        }
-		    },
-		    "nodeDownscaler": {
-		        "emptyNodes": {
-		            "enabled": false,
-		            "delaySeconds": 0
-		        }
-		    }
-		}`))
+	// The full policies are kept, including deprecated fields synced with the default node template.
+	expectedPoliciesBytes, err := normalizeJSON([]byte(apiPolicies))
 	r.NoError(err)
 	expectedPolicies := string(expectedPoliciesBytes)
 
@@ -499,500 +464,212 @@ func TestAutoscalerResource_ReadPoliciesAction(t *testing.T) {
 	result := resource.ReadContext(ctx, data, provider)
 	r.Nil(result)
 	r.Equal(expectedPolicies, data.Get(FieldAutoscalerPolicies))
+	// Without autoscaler_settings in state, the block holds the current policies except for the deprecated ones.
+	r.Equal(true, data.Get("autoscaler_settings.0.enabled"))
+	r.Equal(20, data.Get("autoscaler_settings.0.cluster_limits.0.cpu.0.max_cores"))
+	r.Empty(data.Get("autoscaler_settings.0.unschedulable_pods.0.node_constraints"))
+	r.Empty(data.Get("autoscaler_settings.0.unschedulable_pods.0.headroom"))
 }
 
 func TestAutoscalerResource_CustomizeDiff(t *testing.T) {
-	r := require.New(t)
-	mockctrl := gomock.NewController(t)
-	mockClient := mock_sdk.NewMockClientInterface(mockctrl)
-	ctx := context.Background()
+	clusterId := "b6bfc074-a267-400f-b8f1-db0850c369b1"
+	// The API isn't called, as the JSON is planned on autoscaler_settings, which holds the current policies.
 	provider := &ProviderConfig{
 		api: &sdk.ClientWithResponses{
-			ClientInterface: mockClient,
+			ClientInterface: mock_sdk.NewMockClientInterface(gomock.NewController(t)),
 		},
 	}
-
-	currentPoliciesBytes, err := normalizeJSON([]byte(`
-		{
-		    "enabled": true,
-		    "isScopedMode": false,
-		    "unschedulablePods": {
-		        "enabled": true,
-		        "headroom": {
-		            "cpuPercentage": 10,
-		            "memoryPercentage": 10,
-		            "enabled": true
-		        },
-		        "headroomSpot": {
-		            "cpuPercentage": 10,
-		            "memoryPercentage": 10,
-		            "enabled": true
-		        },
-		        "nodeConstraints": {
-		            "minCpuCores": 2,
-		            "maxCpuCores": 32,
-		            "minRamMib": 4096,
-		            "maxRamMib": 262144,
-		            "enabled": false
-		        },
-		        "diskGibToCpuRatio": 25
-		    },
-		    "clusterLimits": {
-		        "enabled": false,
-		        "cpu": {
-		            "minCores": 1,
-		            "maxCores": 20
-		        }
-		    },
-		    "nodeDownscaler": {
-		        "emptyNodes": {
-		            "enabled": false,
-		            "delaySeconds": 0
-		        }
-		    }
-		}`))
-	r.NoError(err)
-
-	policyChangeBytes, err := normalizeJSON([]byte(`
-		{
-		    "enabled": false,
-		    "unschedulablePods": {
-		        "enabled": false
-			}
-		}`))
-	r.NoError(err)
-
-	expectedPoliciesBytes, err := normalizeJSON([]byte(`
-		{
-		    "enabled": false,
-		    "isScopedMode": false,
-		    "unschedulablePods": {
-		        "enabled": false,
-		        "headroom": {
-		            "cpuPercentage": 10,
-		            "memoryPercentage": 10,
-		            "enabled": true
-		        },
-		        "headroomSpot": {
-		            "cpuPercentage": 10,
-		            "memoryPercentage": 10,
-		            "enabled": true
-		        },
-		        "nodeConstraints": {
-		            "minCpuCores": 2,
-		            "maxCpuCores": 32,
-		            "minRamMib": 4096,
-		            "maxRamMib": 262144,
-		            "enabled": false
-		        },
-		        "diskGibToCpuRatio": 25
-		    },
-		    "clusterLimits": {
-		        "enabled": false,
-		        "cpu": {
-		            "minCores": 1,
-		            "maxCores": 20
-		        }
-		    },
-		    "nodeDownscaler": {
-		        "emptyNodes": {
-		            "enabled": false,
-		            "delaySeconds": 0
-		        }
-		    }
-		}`))
-	r.NoError(err)
-
-	currentPolicies := string(currentPoliciesBytes)
-	policyChanges := string(policyChangeBytes)
-	expectedPolicies := string(expectedPoliciesBytes)
-	resource := resourceAutoscaler()
-
-	clusterId := "cluster_id"
-	val := cty.ObjectVal(map[string]cty.Value{
-		FieldAutoscalerPoliciesJSON: cty.StringVal(policyChanges),
-		FieldClusterId:              cty.StringVal(clusterId),
-	})
-	state := terraform.NewInstanceStateShimmedFromValue(val, 0)
-	data := resource.Data(state)
-	r.NoError(err)
-
-	body := io.NopCloser(bytes.NewReader([]byte(currentPolicies)))
-	response := &http.Response{StatusCode: 200, Body: body}
-
-	mockClient.EXPECT().PoliciesAPIGetClusterPolicies(gomock.Any(), clusterId, gomock.Any()).Return(response, nil).Times(1)
-	mockClient.EXPECT().PoliciesAPIUpsertClusterPoliciesWithBody(gomock.Any(), clusterId, "application/json", gomock.Any()).
-		Times(0)
-
-	result, err := getChangedPolicies(ctx, data, provider, clusterId)
-	r.NoError(err)
-	r.Equal(expectedPolicies, string(result))
-}
-
-func TestAutoscalerResource_ToAutoscalerPolicy(t *testing.T) {
-	tt := map[string]struct {
-		data        cty.Value
-		expected    *types.AutoscalerPolicy
-		shouldFail  bool
-		expectedErr error
-	}{
-		"should return nil when data is nil": {
-			data:     cty.NilVal,
-			expected: nil,
-		},
-		"should handle nested objects": {
-			data: cty.ObjectVal(
-				map[string]cty.Value{
-					FieldAutoscalerSettings: cty.ListVal(
-						[]cty.Value{
-							cty.ObjectVal(
-								map[string]cty.Value{
-									"enabled": cty.BoolVal(true),
-									"unschedulable_pods": cty.ListVal(
-										[]cty.Value{
-											cty.ObjectVal(
-												map[string]cty.Value{
-													"enabled": cty.BoolVal(true),
-													"pod_pinner": cty.ListVal(
-														[]cty.Value{
-															cty.ObjectVal(
-																map[string]cty.Value{
-																	"enabled": cty.BoolVal(true),
-																},
-															),
-														},
-													),
-												},
-											),
-										},
-									),
-								},
-							),
-						},
-					),
-				},
-			),
-			expected: &types.AutoscalerPolicy{
-				Enabled: true,
-				UnschedulablePods: &types.UnschedulablePods{
-					Enabled:         true,
-					CustomInstances: lo.ToPtr(false),
-					PodPinner: &types.PodPinner{
-						Enabled: true,
-					},
-				},
-			},
-		},
-	}
-
-	for testName, test := range tt {
+	policiesJSON := `{"enabled":true,"unschedulablePods":{"enabled":true}}`
+	autoscaler := resourceAutoscaler()
+	data := autoscaler.Data(&terraform.InstanceState{ID: clusterId})
+	require.NoError(t, data.Set(FieldClusterId, clusterId))
+	require.NoError(t, data.Set(FieldAutoscalerPoliciesJSON, policiesJSON))
+	require.NoError(t, data.Set(FieldAutoscalerPolicies, policiesJSON))
+	require.NoError(t, data.Set(FieldAutoscalerSettings, []interface{}{map[string]interface{}{
+		FieldEnabled: true,
+		FieldUnschedulablePods: []interface{}{map[string]interface{}{
+			FieldEnabled: true,
+		}},
+	}}))
+	state := data.State()
+
+	t.Run("should not plan changes when policies match the JSON", func(t *testing.T) {
 		r := require.New(t)
 
-		t.Run(testName, func(t *testing.T) {
-			resource := resourceAutoscaler()
-			state := terraform.NewInstanceStateShimmedFromValue(test.data, 0)
-			actual, err := toAutoscalerPolicy(resource.Data(state))
-
-			if test.shouldFail {
-				r.Error(err)
-				r.Error(err, test.expectedErr)
-				return
-			}
-
-			r.NoError(err)
-			r.Equal(test.expected, actual)
-		})
-	}
-}
-
-func TestAutoscalerResource_GetChangePolicies_ComparePolicyJsonAndDef(t *testing.T) {
-	mockCtrl := gomock.NewController(t)
-	mockClient := mock_sdk.NewMockClientInterface(mockCtrl)
-
-	provider := &ProviderConfig{
-		api: &sdk.ClientWithResponses{
-			ClientInterface: mockClient,
-		},
-	}
-	tt := []struct {
-		name           string
-		current        string
-		policyJson     string
-		policyStruct   cty.Value
-		expectedPolicy string
-	}{
-		// for list types values we can't unset primitive values. So, we need to set them to false. explicitly
-		// we can't make new and old fields %100 consistent because of this.
-		{
-			name:           "simple policy",
-			current:        `{"enabled":false}`,
-			policyJson:     `{"enabled":false,"isScopedMode":false,"nodeTemplatesPartialMatchingEnabled":false}`,
-			policyStruct:   cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{"enabled": cty.BoolVal(false)})}),
-			expectedPolicy: `{"enabled":false,"isScopedMode":false,"nodeTemplatesPartialMatchingEnabled":false}`,
-		},
-		{
-			name:           "with empty current policy",
-			current:        `{}`,
-			policyJson:     `{"enabled":false,"isScopedMode":false,"nodeTemplatesPartialMatchingEnabled":false}`,
-			policyStruct:   cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{"enabled": cty.BoolVal(false)})}),
-			expectedPolicy: `{"enabled":false,"isScopedMode":false,"nodeTemplatesPartialMatchingEnabled":false}`,
-		},
-		{
-			name: "policy with nested objects",
-			current: `{
-				"enabled": true,
-				"unschedulablePods": {
-					"enabled": true,
-					"headroom": {
-						"cpuPercentage": 10,
-						"memoryPercentage": 10,
-						"enabled": true
-					}
-				},
-				"nodeDownscaler": {
-					"emptyNodes": {
-						"enabled": false,
-						"delaySeconds": 0
-					}
-				}
-			}`,
-			policyJson: `{
-				"enabled": false,
-				"isScopedMode": false,
-				"nodeTemplatesPartialMatchingEnabled": false,
-				"unschedulablePods": {
-					"customInstancesEnabled":false,
-					"enabled": false,
-					"headroom": {
-						"cpuPercentage": 100
-					},
-					"headroomSpot": {
-						"cpuPercentage": 10,
-						"memoryPercentage": 10,
-						"enabled": true
-					}
-				}
-			}`,
-			policyStruct: cty.ListVal(
-				[]cty.Value{
-					cty.ObjectVal(
-						map[string]cty.Value{
-							"enabled": cty.BoolVal(false),
-							"unschedulable_pods": cty.ListVal(
-								[]cty.Value{
-									cty.ObjectVal(
-										map[string]cty.Value{
-											"enabled": cty.BoolVal(false),
-											"headroom": cty.ListVal(
-												[]cty.Value{
-													cty.ObjectVal(
-														map[string]cty.Value{
-															"cpu_percentage":    cty.NumberIntVal(100),
-															"memory_percentage": cty.NumberIntVal(10),
-															"enabled":           cty.BoolVal(true),
-														},
-													),
-												},
-											),
-											"headroom_spot": cty.ListVal(
-												[]cty.Value{
-													cty.ObjectVal(
-														map[string]cty.Value{
-															"cpu_percentage":    cty.NumberIntVal(10),
-															"memory_percentage": cty.NumberIntVal(10),
-															"enabled":           cty.BoolVal(true),
-														},
-													),
-												},
-											),
-										},
-									),
-								},
-							),
-						},
-					),
-				},
-			),
-			expectedPolicy: `{
-				"enabled": false,
-				"isScopedMode": false,
-				"nodeTemplatesPartialMatchingEnabled": false,
-				"unschedulablePods": {
-					"customInstancesEnabled": false,
-					"enabled": false,
-					"headroom": {
-						"cpuPercentage": 100,
-						"memoryPercentage": 10,
-						"enabled": true
-					},
-					"headroomSpot": {
-						"cpuPercentage": 10,
-						"memoryPercentage": 10,
-						"enabled": true
-					}
-				},
-				"nodeDownscaler": {
-					"emptyNodes": {
-						"enabled": false,
-						"delaySeconds": 0
-					}
-				}
-			}`,
-		},
-	}
+		diff, err := autoscaler.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+			FieldClusterId:              clusterId,
+			FieldAutoscalerPoliciesJSON: policiesJSON,
+		}), provider)
+		r.NoError(err)
+		r.True(diff == nil || diff.Empty(), "unexpected diff: %v", diff)
+	})
 
-	for _, test := range tt {
+	t.Run("should plan attributes changed by the JSON", func(t *testing.T) {
 		r := require.New(t)
 
-		t.Run(test.name, func(t *testing.T) {
-			current, err := normalizeJSON([]byte(test.current))
-			r.NoError(err)
-
-			mockClient.EXPECT().
-				PoliciesAPIGetClusterPolicies(gomock.Any(), gomock.Any(), gomock.Any()).
-				Times(2).
-				DoAndReturn(
-					func(_ context.Context, _ string) (*http.Response, error) {
-						return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader(current))}, nil
-					},
-				)
-
-			policyJSON, err := normalizeJSON([]byte(test.policyJson))
-			r.NoError(err)
-
-			clusterID := "cluster_id"
-
-			valueWithPolicyJson := cty.ObjectVal(map[string]cty.Value{
-				FieldClusterId:              cty.StringVal(clusterID),
-				FieldAutoscalerPoliciesJSON: cty.StringVal(string(policyJSON)),
-			})
-
-			stateWithPolicyJson := terraform.NewInstanceStateShimmedFromValue(valueWithPolicyJson, 0)
-
-			valueWithPolicyDefinition := cty.ObjectVal(map[string]cty.Value{
-				FieldClusterId:          cty.StringVal(clusterID),
-				FieldAutoscalerSettings: test.policyStruct,
-			})
-
-			stateWithPolicyDefinition := terraform.NewInstanceStateShimmedFromValue(valueWithPolicyDefinition, 0)
-
-			resource := resourceAutoscaler()
-
-			resultPolicyJson, err := getChangedPolicies(context.Background(), resource.Data(stateWithPolicyJson), provider, clusterID)
-			r.NoError(err)
-
-			resultPolicyDefinition, err := getChangedPolicies(context.Background(), resource.Data(stateWithPolicyDefinition), provider, clusterID)
-			r.NoError(err)
-
-			expectedPolicy, err := normalizeJSON([]byte(test.expectedPolicy))
-			r.NoError(err)
-
-			r.Equal(string(expectedPolicy), string(resultPolicyDefinition))
-			r.Equal(string(expectedPolicy), string(resultPolicyJson))
-		})
-	}
+		diff, err := autoscaler.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+			FieldClusterId:              clusterId,
+			FieldAutoscalerPoliciesJSON: `{"enabled":true,"unschedulablePods":{"enabled":false}}`,
+		}), provider)
+		r.NoError(err)
+		r.NotNil(diff)
+		r.Equal("false", diff.Attributes["autoscaler_settings.0.unschedulable_pods.0.enabled"].New)
+		r.NotContains(diff.Attributes, "autoscaler_settings.0.enabled")
+		r.True(diff.Attributes[FieldAutoscalerPolicies].NewComputed)
+	})
 }
 
-// Checks if the value of custom_instances_enabled is retained as received from the API
-// in case when it is not specified (null) in the resource configuration
-func TestAutoscalerResource_GetChangePolicies_AdjustPolicyForDrift(t *testing.T) {
+func TestAutoscalerResource_PoliciesUpdateAction_Settings(t *testing.T) {
 	r := require.New(t)
-	mockCtrl := gomock.NewController(t)
-	mockClient := mock_sdk.NewMockClientInterface(mockCtrl)
-	clusterID := "cluster_id"
+	mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
 	provider := &ProviderConfig{
 		api: &sdk.ClientWithResponses{
 			ClientInterface: mockClient,
 		},
 	}
-	originalPolicyStr := `{
+
+	currentPolicies := `{
 		"enabled": true,
-		"isScopedMode": false,
-		"nodeTemplatesPartialMatchingEnabled": false,
+		"defaultNodeTemplateVersion": "3",
 		"unschedulablePods": {
 			"enabled": true,
-			"custom_instances_enabled": true
-		}
+			"customInstancesEnabled": true,
+			"headroom": {"cpuPercentage": 10, "memoryPercentage": 10, "enabled": true},
+			"nodeConstraints": {"minCpuCores": 2, "maxCpuCores": 32, "minRamMib": 4096, "maxRamMib": 262144, "enabled": false}
+		},
+		"nodeDownscaler": {"enabled": true, "evictor": {"enabled": true, "status": "Running"}}
 	}`
-	expectedPolicyStr := `{
+	// Blocks which aren't configured, and custom_instances_enabled which is synced with the default node template,
+	// keep their values. defaultNodeTemplateVersion is sent back for the API to detect concurrent changes.
+	expectedPolicies := `{
 		"enabled": false,
 		"isScopedMode": false,
 		"nodeTemplatesPartialMatchingEnabled": false,
+		"defaultNodeTemplateVersion": "3",
 		"unschedulablePods": {
 			"enabled": false,
-			"custom_instances_enabled": true
-		}
+			"customInstancesEnabled": true,
+			"diskGibToCpuRatio": 5,
+			"headroom": {"cpuPercentage": 10, "memoryPercentage": 10, "enabled": true},
+			"nodeConstraints": {"minCpuCores": 4, "maxCpuCores": 16, "minRamMib": 2048, "maxRamMib": 262144, "enabled": true}
+		},
+		"nodeDownscaler": {"enabled": true, "evictor": {"enabled": true, "status": "Running"}}
 	}`
 
-	policyJSON, err := normalizeJSON([]byte(originalPolicyStr))
-	r.NoError(err)
+	clusterId := "cluster_id"
+	settings := func(customInstancesEnabled cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			FieldClusterId: cty.StringVal(clusterId),
+			FieldAutoscalerSettings: cty.ListVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					FieldEnabled: cty.BoolVal(false),
+					FieldUnschedulablePods: cty.ListVal([]cty.Value{
+						cty.ObjectVal(map[string]cty.Value{
+							FieldEnabled:                cty.BoolVal(false),
+							FieldCustomInstancesEnabled: customInstancesEnabled,
+							FieldDiskGibToCpuRatio:      cty.NumberIntVal(5),
+							FieldNodeConstraints: cty.ListVal([]cty.Value{
+								cty.ObjectVal(map[string]cty.Value{
+									FieldEnabled:     cty.BoolVal(true),
+									FieldMinCPUCores: cty.NumberIntVal(4),
+									FieldMaxCPUCores: cty.NumberIntVal(16),
+									FieldMinRAMMiB:   cty.NumberIntVal(2048),
+									FieldMaxRAMMiB:   cty.NumberIntVal(262144),
+								}),
+							}),
+						}),
+					}),
+				}),
+			}),
+		})
+	}
+	state := terraform.NewInstanceStateShimmedFromValue(settings(cty.False), 0)
+	state.RawConfig = settings(cty.NullVal(cty.Bool))
+	resource := resourceAutoscaler()
+	data := resource.Data(state)
 
-	expectedPolicyJSON, err := normalizeJSON([]byte(expectedPolicyStr))
-	r.NoError(err)
+	mockClient.EXPECT().PoliciesAPIGetClusterPolicies(gomock.Any(), clusterId, gomock.Any()).
+		DoAndReturn(func(context.Context, string, ...sdk.RequestEditorFn) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(currentPolicies)))}, nil
+		}).Times(2)
+	mockClient.EXPECT().PoliciesAPIUpsertClusterPoliciesWithBody(gomock.Any(), clusterId, "application/json", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ string, body io.Reader) (*http.Response, error) {
+			got, err := io.ReadAll(body)
+			r.NoError(err)
+			requirePoliciesEqual(r, expectedPolicies, got)
+			return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte("{}")))}, nil
+		})
 
-	mockClient.EXPECT().
-		PoliciesAPIGetClusterPolicies(gomock.Any(), gomock.Any(), gomock.Any()).
-		Times(1).
-		DoAndReturn(
-			func(_ context.Context, _ string) (*http.Response, error) {
-				return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader(policyJSON))}, nil
-			},
-		)
-
-	value := cty.ObjectVal(map[string]cty.Value{
-		FieldClusterId: cty.StringVal(clusterID),
-		FieldAutoscalerSettings: cty.ListVal(
-			[]cty.Value{
-				cty.ObjectVal(
-					map[string]cty.Value{
-						"enabled": cty.BoolVal(false),
-						"unschedulable_pods": cty.ListVal(
-							[]cty.Value{
-								cty.ObjectVal(
-									map[string]cty.Value{
-										"enabled": cty.BoolVal(false),
-									},
-								),
-							},
-						),
-					},
-				),
-			},
-		),
-	})
-	rawConfig := cty.ObjectVal(
-		map[string]cty.Value{
-			"autoscaler_settings": cty.ListVal(
-				[]cty.Value{
-					cty.ObjectVal(
-						map[string]cty.Value{
-							"enabled": cty.BoolVal(false),
-							"unschedulable_pods": cty.ListVal(
-								[]cty.Value{
-									cty.ObjectVal(
-										map[string]cty.Value{
-											"enabled":                  cty.BoolVal(false),
-											"custom_instances_enabled": cty.NullVal(cty.Bool),
-										},
-									),
-								},
-							),
-						},
-					),
-				},
-			),
+	result := resource.UpdateContext(context.Background(), data, provider)
+	r.Nil(result)
+	// Synced attributes are refreshed, while the API ignores disk_gib_to_cpu_ratio, so it keeps its value.
+	r.Equal(true, data.Get("autoscaler_settings.0.unschedulable_pods.0.custom_instances_enabled"))
+	r.Equal(5, data.Get("autoscaler_settings.0.unschedulable_pods.0.disk_gib_to_cpu_ratio"))
+	r.Equal(32, data.Get("autoscaler_settings.0.unschedulable_pods.0.node_constraints.0.max_cpu_cores"))
+}
+
+func TestAutoscalerResource_ConfiguredAutoscalerSettings_SpotInstances(t *testing.T) {
+	spotInstances := func(priceIncreaseLimit, predictionsType cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			FieldAutoscalerSettings: cty.ListVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					FieldSpotInstances: cty.ListVal([]cty.Value{
+						cty.ObjectVal(map[string]cty.Value{
+							FieldEnabled:                         cty.BoolVal(true),
+							FieldSpotDiversityPriceIncreaseLimit: priceIncreaseLimit,
+							FieldSpotInterruptionPredictions: cty.ListVal([]cty.Value{
+								cty.ObjectVal(map[string]cty.Value{
+									FieldEnabled:                         cty.BoolVal(true),
+									FieldSpotInterruptionPredictionsType: predictionsType,
+								}),
+							}),
+						}),
+					}),
+				}),
+			}),
+		})
+	}
+	state := terraform.NewInstanceStateShimmedFromValue(spotInstances(cty.NumberIntVal(20), cty.StringVal("CASTAIInterruptionPredictions")), 0)
+	current := `{"enabled":false,"maxReclaimRate":0,"spotDiversityEnabled":true,"spotDiversityPriceIncreaseLimitPercent":30,"spotInterruptionPredictions":{"enabled":false,"type":"AWSRebalanceRecommendations"}}`
+
+	tt := map[string]struct {
+		rawConfig cty.Value
+		expected  string
+	}{
+		"should keep synced values missing from the configuration": {
+			rawConfig: spotInstances(cty.NullVal(cty.Number), cty.NullVal(cty.String)),
+			expected:  `{"enabled":true,"maxReclaimRate":0,"spotDiversityEnabled":false,"spotDiversityPriceIncreaseLimitPercent":30,"spotInterruptionPredictions":{"enabled":true,"type":"AWSRebalanceRecommendations"}}`,
 		},
-	)
+		"should send values specified in the configuration": {
+			rawConfig: spotInstances(cty.NumberIntVal(20), cty.StringVal("CASTAIInterruptionPredictions")),
+			expected:  `{"enabled":true,"maxReclaimRate":0,"spotDiversityEnabled":false,"spotDiversityPriceIncreaseLimitPercent":20,"spotInterruptionPredictions":{"enabled":true,"type":"CASTAIInterruptionPredictions"}}`,
+		},
+	}
 
-	stateWithPolicyDefinition := terraform.NewInstanceStateShimmedFromValue(value, 0)
-	stateWithPolicyDefinition.RawConfig = rawConfig
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+			state.RawConfig = test.rawConfig
+			data := resourceAutoscaler().Data(state)
 
-	resource := resourceAutoscaler()
-	data := resource.Data(stateWithPolicyDefinition)
-	result, err := getChangedPolicies(context.Background(), data, provider, clusterID)
+			var policies sdk.PoliciesV1Policies
+			r.NoError(json.Unmarshal([]byte(`{"spotInstances":`+current+`}`), &policies))
+			expandAutoscalerSettings(configuredAutoscalerSettings(data), &policies)
 
-	r.NoError(err)
-	r.Equal(string(expectedPolicyJSON), string(result))
+			spotInstancesJSON, err := json.Marshal(policies.SpotInstances)
+			r.NoError(err)
+			r.JSONEq(test.expected, string(spotInstancesJSON))
+		})
+	}
+}
+
+// requirePoliciesEqual compares policies JSON by its typed model, so that unset fields, which are sent as nulls, don't
+// need to be listed.
+func requirePoliciesEqual(r *require.Assertions, expected string, actual []byte) {
+	var expectedPolicies, actualPolicies sdk.PoliciesV1Policies
+	r.NoError(json.Unmarshal([]byte(expected), &expectedPolicies))
+	r.NoError(json.Unmarshal(actual, &actualPolicies), string(actual))
+	r.Equal(expectedPolicies, actualPolicies, string(actual))
 }
 
 func JSONBytesEqual(a, b []byte) (bool, error) {
@@ -1250,9 +927,10 @@ resource "castai_node_template" "default" {
 
 func TestAutoscalerResource_FlattenAutoscalerSettings(t *testing.T) {
 	tests := []struct {
-		name     string
-		input    string
-		expected map[string]interface{}
+		name           string
+		input          string
+		withDeprecated bool
+		expected       map[string]interface{}
 	}{
 		{
 			name: "basic policy with top-level fields",
@@ -1295,6 +973,54 @@ func TestAutoscalerResource_FlattenAutoscalerSettings(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "policy with deprecated fields included",
+			input: `{
+				"unschedulablePods": {
+					"enabled": true,
+					"headroom": {"cpuPercentage": 10, "memoryPercentage": 10, "enabled": true},
+					"nodeConstraints": {"minCpuCores": 2, "maxCpuCores": 32, "enabled": true},
+					"customInstancesEnabled": true,
+					"diskGibToCpuRatio": 25
+				},
+				"spotInstances": {"enabled": true, "maxReclaimRate": 10, "spotInterruptionPredictions": {"enabled": true, "type": "CASTAIInterruptionPredictions"}}
+			}`,
+			withDeprecated: true,
+			expected: map[string]interface{}{
+				"unschedulable_pods": []interface{}{
+					map[string]interface{}{
+						"enabled": true,
+						"headroom": []interface{}{
+							map[string]interface{}{
+								"cpu_percentage":    10,
+								"memory_percentage": 10,
+								"enabled":           true,
+							},
+						},
+						"node_constraints": []interface{}{
+							map[string]interface{}{
+								"min_cpu_cores": 2,
+								"max_cpu_cores": 32,
+								"enabled":       true,
+							},
+						},
+						"custom_instances_enabled": true,
+					},
+				},
+				"spot_instances": []interface{}{
+					map[string]interface{}{
+						"enabled":          true,
+						"max_reclaim_rate": 10,
+						"spot_interruption_predictions": []interface{}{
+							map[string]interface{}{
+								"enabled":                            true,
+								"spot_interruption_predictions_type": "CASTAIInterruptionPredictions",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "policy with cluster limits",
 			input: `{
@@ -1314,8 +1040,8 @@ func TestAutoscalerResource_FlattenAutoscalerSettings(t *testing.T) {
 						"enabled": true,
 						"cpu": []interface{}{
 							map[string]interface{}{
-								"min_cores": float64(1),
-								"max_cores": float64(100),
+								"min_cores": int(1),
+								"max_cores": int(100),
 							},
 						},
 					},
@@ -1352,7 +1078,7 @@ func TestAutoscalerResource_FlattenAutoscalerSettings(t *testing.T) {
 						"empty_nodes": []interface{}{
 							map[string]interface{}{
 								"enabled":       true,
-								"delay_seconds": float64(300),
+								"delay_seconds": int(300),
 							},
 						},
 						"evictor": []interface{}{
@@ -1362,7 +1088,7 @@ func TestAutoscalerResource_FlattenAutoscalerSettings(t *testing.T) {
 								"aggressive_mode":                        true,
 								"scoped_mode":                            false,
 								"cycle_interval":                         "5m",
-								"node_grace_period_minutes":              float64(10),
+								"node_grace_period_minutes":              int(10),
 								"pod_eviction_failure_back_off_interval": "10s",
 								"ignore_pod_disruption_budgets":          false,
 							},
@@ -1403,7 +1129,7 @@ func TestAutoscalerResource_FlattenAutoscalerSettings(t *testing.T) {
 						"empty_nodes": []interface{}{
 							map[string]interface{}{
 								"enabled":       true,
-								"delay_seconds": float64(120),
+								"delay_seconds": int(120),
 							},
 						},
 						"evictor": []interface{}{
@@ -1422,14 +1148,13 @@ func TestAutoscalerResource_FlattenAutoscalerSettings(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			r := require.New(t)
 
-			result, err := flattenAutoscalerSettings([]byte(tt.input))
-			r.NoError(err)
-			r.NotNil(result)
-			r.Len(result, 1)
+			var policies sdk.PoliciesV1Policies
+			r.NoError(json.Unmarshal([]byte(tt.input), &policies))
+			result := flattenAutoscalerPolicies(&policies, tt.withDeprecated)
 
 			// Compare each key in expected with result
 			for key, expectedValue := range tt.expected {
-				actualValue, ok := result[0][key]
+				actualValue, ok := result[key]
 				r.True(ok, "expected key %s not found in result", key)
 				r.Equal(expectedValue, actualValue, "mismatch for key %s", key)
 			}
@@ -1437,26 +1162,21 @@ func TestAutoscalerResource_FlattenAutoscalerSettings(t *testing.T) {
 	}
 }
 
-func TestAutoscalerResource_FlattenAutoscalerSettings_InvalidJSON(t *testing.T) {
-	r := require.New(t)
-
-	_, err := flattenAutoscalerSettings([]byte("invalid json"))
-	r.Error(err)
-	r.Contains(err.Error(), "unmarshaling policies JSON")
-}
-
 func TestAutoscalerResource_FlattenEvictor(t *testing.T) {
 	r := require.New(t)
 
-	input := map[string]interface{}{
-		"enabled":                           true,
-		"dryRun":                            false,
-		"aggressiveMode":                    true,
-		"scopedMode":                        false,
-		"cycleInterval":                     "1m",
-		"nodeGracePeriodMinutes":            5,
-		"podEvictionFailureBackOffInterval": "5s",
-		"ignorePodDisruptionBudgets":        false,
+	input := &sdk.PoliciesV1Evictor{
+		Enabled:                           lo.ToPtr(true),
+		DryRun:                            lo.ToPtr(false),
+		AggressiveMode:                    lo.ToPtr(true),
+		ScopedMode:                        lo.ToPtr(false),
+		CycleInterval:                     lo.ToPtr("1m"),
+		NodeGracePeriodMinutes:            lo.ToPtr[int32](5),
+		PodEvictionFailureBackOffInterval: lo.ToPtr("5s"),
+		IgnorePodDisruptionBudgets:        lo.ToPtr(false),
+		SoftTainting:                      lo.ToPtr(true),
+		CleanupKarpenterNodes:             lo.ToPtr(false),
+		Status:                            lo.ToPtr(sdk.PoliciesV1EvictorStatus("Running")),
 	}
 
 	result := flattenEvictor(input)
@@ -1469,228 +1189,282 @@ func TestAutoscalerResource_FlattenEvictor(t *testing.T) {
 	r.Equal(5, result["node_grace_period_minutes"])
 	r.Equal("5s", result["pod_eviction_failure_back_off_interval"])
 	r.Equal(false, result["ignore_pod_disruption_budgets"])
+	r.Equal(true, result["soft_tainting"])
+	r.Equal(false, result["cleanup_karpenter_nodes"])
+	r.NotContains(result, "status")
 }
 
-func TestAutoscalerResource_FilterVolatileFields(t *testing.T) {
-	tests := []struct {
-		name        string
-		input       string
-		shouldExist []string
-		shouldGone  []string
-	}{
-		{
-			name:        "removes defaultNodeTemplateVersion",
-			input:       `{"enabled":true,"defaultNodeTemplateVersion":"5","isScopedMode":false}`,
-			shouldExist: []string{"enabled", "isScopedMode"},
-			shouldGone:  []string{"defaultNodeTemplateVersion"},
-		},
-		{
-			name:        "removes spotInstances (synced with node template)",
-			input:       `{"enabled":true,"spotInstances":{"enabled":true,"maxReclaimRate":50}}`,
-			shouldExist: []string{"enabled"},
-			shouldGone:  []string{"spotInstances"},
-		},
-		{
-			name:        "handles missing defaultNodeTemplateVersion",
-			input:       `{"enabled":true,"isScopedMode":false}`,
-			shouldExist: []string{"enabled", "isScopedMode"},
-			shouldGone:  []string{"defaultNodeTemplateVersion"},
-		},
-		{
-			name:        "preserves nested structures except deprecated fields",
-			input:       `{"enabled":true,"defaultNodeTemplateVersion":"10","nodeDownscaler":{"enabled":true}}`,
-			shouldExist: []string{"enabled", "nodeDownscaler"},
-			shouldGone:  []string{"defaultNodeTemplateVersion"},
+func TestAutoscalerResource_ReadRefreshesAutoscalerSettings(t *testing.T) {
+	r := require.New(t)
+	mockctrl := gomock.NewController(t)
+	mockClient := mock_sdk.NewMockClientInterface(mockctrl)
+	ctx := context.Background()
+	provider := &ProviderConfig{
+		api: &sdk.ClientWithResponses{
+			ClientInterface: mockClient,
 		},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			r := require.New(t)
-
-			result := filterVolatileFields([]byte(tt.input))
-
-			var filtered map[string]interface{}
-			err := json.Unmarshal(result, &filtered)
-			r.NoError(err)
-
-			for _, key := range tt.shouldExist {
-				_, exists := filtered[key]
-				r.True(exists, "expected key %q to exist", key)
-			}
-
-			for _, key := range tt.shouldGone {
-				_, exists := filtered[key]
-				r.False(exists, "expected key %q to be removed", key)
-			}
-		})
-	}
-}
-
-func TestAutoscalerResource_FilterVolatileFields_NestedDeprecated(t *testing.T) {
-	r := require.New(t)
-
-	// Test that nested deprecated fields in unschedulablePods are removed
-	input := `{
-		"enabled": true,
+	apiPolicies := `{
+		"enabled": false,
+		"isScopedMode": false,
 		"unschedulablePods": {
 			"enabled": true,
-			"nodeConstraints": {"minCpuCores": 2, "maxCpuCores": 96},
-			"customInstancesEnabled": true,
-			"headroom": {"cpuPercentage": 10}
+			"headroom": {"cpuPercentage": 20, "memoryPercentage": 20, "enabled": true}
+		},
+		"clusterLimits": {
+			"enabled": true,
+			"cpu": {"minCores": 1, "maxCores": 100}
+		},
+		"nodeDownscaler": {
+			"enabled": true,
+			"evictor": {
+				"enabled": true,
+				"cycleInterval": "5m",
+				"softTainting": true,
+				"status": "Running"
+			}
 		}
 	}`
 
-	result := filterVolatileFields([]byte(input))
-
-	var filtered map[string]interface{}
-	err := json.Unmarshal(result, &filtered)
-	r.NoError(err)
-
-	// unschedulablePods should still exist
-	up, ok := filtered["unschedulablePods"].(map[string]interface{})
-	r.True(ok, "unschedulablePods should exist")
-
-	// enabled should be preserved
-	_, exists := up["enabled"]
-	r.True(exists, "unschedulablePods.enabled should be preserved")
+	clusterId := "cluster_id"
+	val := cty.ObjectVal(map[string]cty.Value{
+		FieldClusterId: cty.StringVal(clusterId),
+		FieldAutoscalerSettings: cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				FieldEnabled: cty.BoolVal(true),
+				FieldUnschedulablePods: cty.ListVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{
+						FieldEnabled: cty.BoolVal(true),
+						FieldHeadroom: cty.ListVal([]cty.Value{
+							cty.ObjectVal(map[string]cty.Value{
+								FieldCPUPercentage:    cty.NumberIntVal(10),
+								FieldMemoryPercentage: cty.NumberIntVal(10),
+								FieldEnabled:          cty.BoolVal(true),
+							}),
+						}),
+					}),
+				}),
+				FieldNodeDownscaler: cty.ListVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{
+						FieldEnabled: cty.BoolVal(true),
+						FieldEvictor: cty.ListVal([]cty.Value{
+							cty.ObjectVal(map[string]cty.Value{
+								FieldEnabled:              cty.BoolVal(true),
+								FieldEvictorCycleInterval: cty.StringVal("1m"),
+							}),
+						}),
+					}),
+				}),
+			}),
+		}),
+	})
+	state := terraform.NewInstanceStateShimmedFromValue(val, 0)
+	resource := resourceAutoscaler()
+	data := resource.Data(state)
 
-	// headroom should be preserved (not a synced field)
-	_, exists = up["headroom"]
-	r.True(exists, "unschedulablePods.headroom should be preserved")
+	body := io.NopCloser(bytes.NewReader([]byte(apiPolicies)))
+	mockClient.EXPECT().PoliciesAPIGetClusterPolicies(gomock.Any(), clusterId, gomock.Any()).
+		Return(&http.Response{StatusCode: 200, Body: body}, nil)
 
-	// nodeConstraints should be removed (synced with node template)
-	_, exists = up["nodeConstraints"]
-	r.False(exists, "unschedulablePods.nodeConstraints should be removed")
+	result := resource.ReadContext(ctx, data, provider)
+	r.Nil(result)
 
-	// customInstancesEnabled should be removed (synced with node template)
-	_, exists = up["customInstancesEnabled"]
-	r.False(exists, "unschedulablePods.customInstancesEnabled should be removed")
+	r.Equal(false, data.Get("autoscaler_settings.0.enabled"))
+	r.Equal("5m", data.Get("autoscaler_settings.0.node_downscaler.0.evictor.0.cycle_interval"))
+	r.Equal(true, data.Get("autoscaler_settings.0.node_downscaler.0.evictor.0.soft_tainting"))
+	// Deprecated headroom isn't synced with the default node template, so it's refreshed too.
+	r.Equal(20, data.Get("autoscaler_settings.0.unschedulable_pods.0.headroom.0.cpu_percentage"))
+	// Blocks missing from state are not added.
+	r.Empty(data.Get("autoscaler_settings.0.unschedulable_pods.0.headroom_spot"))
+	r.Empty(data.Get("autoscaler_settings.0.cluster_limits"))
 }
 
-func TestAutoscalerResource_FilterVolatileFields_StatusFields(t *testing.T) {
-	r := require.New(t)
-
-	tests := []struct {
-		name    string
-		input   string
-		checkFn func(map[string]interface{})
-	}{
-		{
-			name: "removes podPinner status",
-			input: `{
-				"enabled": true,
-				"unschedulablePods": {
-					"enabled": true,
-					"podPinner": {
-						"enabled": true,
-						"status": "PodPinnerStatus_Unknown"
-					}
-				}
-			}`,
-			checkFn: func(filtered map[string]interface{}) {
-				up, ok := filtered["unschedulablePods"].(map[string]interface{})
-				r.True(ok, "unschedulablePods should exist")
+func TestAutoscalerResource_DiffAutoscalerSettings(t *testing.T) {
+	clusterId := "b6bfc074-a267-400f-b8f1-db0850c369b1"
+	settings := func(enabled bool) []interface{} {
+		return []interface{}{map[string]interface{}{
+			FieldEnabled: enabled,
+			FieldUnschedulablePods: []interface{}{map[string]interface{}{
+				FieldEnabled: true,
+			}},
+		}}
+	}
+	// The API isn't called, as autoscaler_settings are diffed per attribute.
+	provider := &ProviderConfig{
+		api: &sdk.ClientWithResponses{
+			ClientInterface: mock_sdk.NewMockClientInterface(gomock.NewController(t)),
+		},
+	}
+	autoscaler := resourceAutoscaler()
+	data := autoscaler.Data(&terraform.InstanceState{ID: clusterId})
+	require.NoError(t, data.Set(FieldClusterId, clusterId))
+	require.NoError(t, data.Set(FieldAutoscalerPolicies, `{"enabled":true}`))
+	require.NoError(t, data.Set(FieldAutoscalerSettings, settings(true)))
+	state := data.State()
+
+	t.Run("should not plan policies when settings are unchanged", func(t *testing.T) {
+		r := require.New(t)
 
-				podPinner, ok := up["podPinner"].(map[string]interface{})
-				r.True(ok, "podPinner should exist")
+		diff, err := autoscaler.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+			FieldClusterId:          clusterId,
+			FieldAutoscalerSettings: settings(true),
+		}), provider)
+		r.NoError(err)
+		r.True(diff == nil || diff.Empty(), "unexpected diff: %v", diff)
+	})
 
-				// enabled should be preserved
-				enabled, exists := podPinner["enabled"]
-				r.True(exists, "podPinner.enabled should exist")
-				r.True(enabled.(bool), "podPinner.enabled should be true")
+	t.Run("should plan changed attributes and leave policies unknown", func(t *testing.T) {
+		r := require.New(t)
 
-				// status should be removed
-				_, exists = podPinner["status"]
-				r.False(exists, "podPinner.status should be removed")
-			},
-		},
-		{
-			name: "removes evictor status",
-			input: `{
-				"enabled": true,
-				"nodeDownscaler": {
-					"enabled": true,
-					"evictor": {
-						"enabled": true,
-						"dryRun": false,
-						"status": "Unknown"
-					}
-				}
-			}`,
-			checkFn: func(filtered map[string]interface{}) {
-				nd, ok := filtered["nodeDownscaler"].(map[string]interface{})
-				r.True(ok, "nodeDownscaler should exist")
+		diff, err := autoscaler.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+			FieldClusterId:          clusterId,
+			FieldAutoscalerSettings: settings(false),
+		}), provider)
+		r.NoError(err)
+		r.NotNil(diff)
+		r.Equal("false", diff.Attributes["autoscaler_settings.0.enabled"].New)
+		r.True(diff.Attributes[FieldAutoscalerPolicies].NewComputed)
+	})
+}
 
-				evictor, ok := nd["evictor"].(map[string]interface{})
-				r.True(ok, "evictor should exist")
+func TestAutoscalerResource_StateUpgradeV0(t *testing.T) {
+	t.Run("should fill defaults of attributes added to autoscaler_settings", func(t *testing.T) {
+		r := require.New(t)
+		rawState := map[string]interface{}{
+			FieldClusterId: "cluster_id",
+			FieldAutoscalerSettings: []interface{}{map[string]interface{}{
+				FieldEnabled: true,
+				FieldNodeDownscaler: []interface{}{map[string]interface{}{
+					FieldEnabled: true,
+					FieldEvictor: []interface{}{map[string]interface{}{
+						FieldEnabled:               true,
+						FieldEvictorAggressiveMode: true,
+					}},
+				}},
+			}},
+		}
 
-				// enabled and dryRun should be preserved
-				enabled, exists := evictor["enabled"]
-				r.True(exists, "evictor.enabled should exist")
-				r.True(enabled.(bool), "evictor.enabled should be true")
+		upgraded, err := autoscalerStateUpgradeV0(context.Background(), rawState, nil)
+		r.NoError(err)
 
-				dryRun, exists := evictor["dryRun"]
-				r.True(exists, "evictor.dryRun should exist")
-				r.False(dryRun.(bool), "evictor.dryRun should be false")
+		settings := upgraded[FieldAutoscalerSettings].([]interface{})[0].(map[string]interface{})
+		r.Equal(true, settings[FieldEnabled])
+		nodeDownscaler := settings[FieldNodeDownscaler].([]interface{})[0].(map[string]interface{})
+		evictor := nodeDownscaler[FieldEvictor].([]interface{})[0].(map[string]interface{})
+		r.Equal(true, evictor[FieldEvictorAggressiveMode])
+		r.Equal(false, evictor[FieldEvictorSoftTainting])
+		r.Equal(false, evictor[FieldEvictorCleanupKarpenterNodes])
+	})
 
-				// status should be removed
-				_, exists = evictor["status"]
-				r.False(exists, "evictor.status should be removed")
+	t.Run("should migrate states which only hold policies JSON", func(t *testing.T) {
+		r := require.New(t)
+		// The API isn't called, as the JSON is planned on the migrated autoscaler_settings.
+		provider := &ProviderConfig{
+			api: &sdk.ClientWithResponses{
+				ClientInterface: mock_sdk.NewMockClientInterface(gomock.NewController(t)),
 			},
-		},
-		{
-			name: "removes both podPinner and evictor status",
-			input: `{
-				"enabled": true,
-				"unschedulablePods": {
-					"enabled": true,
-					"podPinner": {
-						"enabled": true,
-						"status": "PodPinnerStatus_Missing"
-					}
-				},
-				"nodeDownscaler": {
-					"enabled": true,
-					"evictor": {
-						"enabled": true,
-						"status": "Missing"
-					}
-				}
-			}`,
-			checkFn: func(filtered map[string]interface{}) {
-				// Check podPinner
-				up, ok := filtered["unschedulablePods"].(map[string]interface{})
-				r.True(ok, "unschedulablePods should exist")
+		}
+		autoscaler := resourceAutoscaler()
+
+		clusterId := "cluster_id"
+		policiesJSON := `{"enabled":true,"nodeDownscaler":{"enabled":true,"evictor":{"aggressiveMode":true,"enabled":true}}}`
+		rawState := map[string]interface{}{
+			FieldClusterId:              clusterId,
+			FieldAutoscalerPoliciesJSON: policiesJSON,
+			FieldAutoscalerPolicies:     `{"enabled":true,"nodeDownscaler":{"enabled":true,"evictor":{"aggressiveMode":true,"enabled":true,"cycleInterval":"5m"}}}`,
+		}
 
-				podPinner, ok := up["podPinner"].(map[string]interface{})
-				r.True(ok, "podPinner should exist")
+		upgraded, err := autoscalerStateUpgradeV0(context.Background(), rawState, nil)
+		r.NoError(err)
 
-				_, exists := podPinner["status"]
-				r.False(exists, "podPinner.status should be removed")
+		settings := upgraded[FieldAutoscalerSettings].([]interface{})[0].(map[string]interface{})
+		r.Equal(true, settings[FieldEnabled])
+		nodeDownscaler := settings[FieldNodeDownscaler].([]interface{})[0].(map[string]interface{})
+		evictor := nodeDownscaler[FieldEvictor].([]interface{})[0].(map[string]interface{})
+		r.Equal(true, evictor[FieldEvictorAggressiveMode])
+		r.Equal("5m", evictor[FieldEvictorCycleInterval])
 
-				// Check evictor
-				nd, ok := filtered["nodeDownscaler"].(map[string]interface{})
-				r.True(ok, "nodeDownscaler should exist")
+		data := autoscaler.Data(&terraform.InstanceState{ID: clusterId})
+		for key, value := range upgraded {
+			r.NoError(data.Set(key, value))
+		}
 
-				evictor, ok := nd["evictor"].(map[string]interface{})
-				r.True(ok, "evictor should exist")
+		diff, err := autoscaler.Diff(context.Background(), data.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+			FieldClusterId:              clusterId,
+			FieldAutoscalerPoliciesJSON: policiesJSON,
+		}), provider)
+		r.NoError(err)
+		r.True(diff == nil || diff.Empty(), "unexpected diff: %v", diff)
+	})
+}
 
-				_, exists = evictor["status"]
-				r.False(exists, "evictor.status should be removed")
-			},
+func TestAutoscalerResource_ValidateAutoscalerSettings(t *testing.T) {
+	tt := map[string]struct {
+		settings    cty.Value
+		expectedErr string
+	}{
+		"should accept matching node constraints": {
+			settings: cty.ObjectVal(map[string]cty.Value{
+				FieldUnschedulablePods: cty.ListVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{
+						FieldNodeConstraints: cty.ListVal([]cty.Value{
+							cty.ObjectVal(map[string]cty.Value{
+								FieldMinCPUCores: cty.NumberIntVal(2),
+								FieldMaxCPUCores: cty.NumberIntVal(8),
+							}),
+						}),
+					}),
+				}),
+			}),
+		},
+		"should reject min CPU above max CPU": {
+			settings: cty.ObjectVal(map[string]cty.Value{
+				FieldUnschedulablePods: cty.ListVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{
+						FieldNodeConstraints: cty.ListVal([]cty.Value{
+							cty.ObjectVal(map[string]cty.Value{
+								FieldMinCPUCores: cty.NumberIntVal(16),
+								FieldMaxCPUCores: cty.NumberIntVal(8),
+							}),
+						}),
+					}),
+				}),
+			}),
+			expectedErr: "min_cpu_cores (16) must not be greater than max_cpu_cores (8)",
+		},
+		"should reject cluster limits min cores above max cores": {
+			settings: cty.ObjectVal(map[string]cty.Value{
+				FieldClusterLimits: cty.ListVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{
+						FieldCPU: cty.ListVal([]cty.Value{
+							cty.ObjectVal(map[string]cty.Value{
+								FieldMinCores: cty.NumberIntVal(50),
+								FieldMaxCores: cty.NumberIntVal(20),
+							}),
+						}),
+					}),
+				}),
+			}),
+			expectedErr: "min_cores (50) must not be greater than max_cores (20)",
 		},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := filterVolatileFields([]byte(tt.input))
-
-			var filtered map[string]interface{}
-			err := json.Unmarshal(result, &filtered)
-			r.NoError(err, "should unmarshal filtered result")
+	for name, test := range tt {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+			val := cty.ObjectVal(map[string]cty.Value{
+				FieldClusterId:          cty.StringVal("cluster_id"),
+				FieldAutoscalerSettings: cty.ListVal([]cty.Value{test.settings}),
+			})
+			state := terraform.NewInstanceStateShimmedFromValue(val, 0)
 
-			tt.checkFn(filtered)
+			err := validateAutoscalerSettings(resourceAutoscaler().Data(state))
+			if test.expectedErr == "" {
+				r.NoError(err)
+				return
+			}
+			r.ErrorContains(err, test.expectedErr)
 		})
 	}
 }
@@ -0,0 +1,264 @@
+package castai
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+	"github.com/castai/terraform-provider-castai/castai/sdk/cluster_autoscaler"
+)
+
+const (
+	FieldClusterHibernationStateDesiredState     = "desired_state"
+	FieldClusterHibernationStateStopControlPlane = "stop_control_plane"
+	FieldClusterHibernationStateResumeNodeConfig = "resume_node_config"
+	FieldClusterHibernationStateStatus           = "status"
+)
+
+const (
+	ClusterHibernationStateRunning    = "running"
+	ClusterHibernationStateHibernated = "hibernated"
+)
+
+func resourceClusterHibernationState() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceClusterHibernationStateRead,
+		CreateContext: resourceClusterHibernationStateCreate,
+		UpdateContext: resourceClusterHibernationStateUpdate,
+		DeleteContext: resourceClusterHibernationStateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: clusterHibernationStateImporter,
+		},
+		Description: "Hibernates or resumes a cluster connected to CAST AI. Destroying the resource leaves the cluster in its current state.",
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			FieldClusterID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsUUID),
+				Description:      "CAST AI cluster id.",
+			},
+			FieldClusterHibernationStateDesiredState: {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+					ClusterHibernationStateRunning,
+					ClusterHibernationStateHibernated,
+				}, false)),
+				Description: fmt.Sprintf("Desired state of the cluster. Allowed values: %s, %s.", ClusterHibernationStateRunning, ClusterHibernationStateHibernated),
+			},
+			FieldClusterHibernationStateStopControlPlane: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Stops the cluster control plane during hibernation. Currently only supported on AKS.",
+			},
+			FieldClusterHibernationStateResumeNodeConfig: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        hibernationNodeConfigResource,
+				Description: "Configuration of the node created to resume the cluster. Required when resuming a hibernated cluster.",
+			},
+			FieldClusterHibernationStateStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current status of the cluster.",
+			},
+		},
+	}
+}
+
+func resourceClusterHibernationStateRead(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+
+	resp, err := fetchClusterData(ctx, client, data.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("getting cluster: %w", err))
+	}
+	if resp == nil {
+		data.SetId("")
+		return nil
+	}
+
+	status := lo.FromPtr(resp.JSON200.Status)
+	if err := data.Set(FieldClusterID, data.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("setting cluster id: %w", err))
+	}
+	if err := data.Set(FieldClusterHibernationStateDesiredState, clusterHibernationState(status)); err != nil {
+		return diag.FromErr(fmt.Errorf("setting desired state: %w", err))
+	}
+	if err := data.Set(FieldClusterHibernationStateStatus, status); err != nil {
+		return diag.FromErr(fmt.Errorf("setting status: %w", err))
+	}
+
+	return nil
+}
+
+func resourceClusterHibernationStateCreate(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
+	data.SetId(data.Get(FieldClusterID).(string))
+
+	if err := applyClusterHibernationState(ctx, data, meta, data.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceClusterHibernationStateRead(ctx, data, meta)
+}
+
+func resourceClusterHibernationStateUpdate(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
+	if err := applyClusterHibernationState(ctx, data, meta, data.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceClusterHibernationStateRead(ctx, data, meta)
+}
+
+func resourceClusterHibernationStateDelete(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
+	tflog.Info(ctx, "removing cluster hibernation state from state, cluster is left as is", map[string]any{"cluster_id": data.Id()})
+	return nil
+}
+
+func clusterHibernationStateImporter(ctx context.Context, data *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if err := data.Set(FieldClusterID, data.Id()); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{data}, nil
+}
+
+// applyClusterHibernationState triggers hibernation or resume of the cluster if it is not in the desired state yet,
+// and waits for the triggered operation to finish.
+func applyClusterHibernationState(ctx context.Context, data *schema.ResourceData, meta any, timeout time.Duration) error {
+	client := meta.(*ProviderConfig).api
+	clusterID := data.Id()
+
+	resp, err := fetchClusterData(ctx, client, clusterID)
+	if err != nil {
+		return fmt.Errorf("getting cluster: %w", err)
+	}
+	if resp == nil {
+		return fmt.Errorf("cluster %s not found", clusterID)
+	}
+
+	desired := data.Get(FieldClusterHibernationStateDesiredState).(string)
+	status := lo.FromPtr(resp.JSON200.Status)
+	if clusterHibernationState(status) == desired {
+		tflog.Info(ctx, "cluster is already in desired state", map[string]any{"cluster_id": clusterID, "status": status})
+		return nil
+	}
+
+	var operationID string
+	switch desired {
+	case ClusterHibernationStateHibernated:
+		params := &sdk.ExternalClusterAPITriggerHibernateClusterParams{}
+		if data.Get(FieldClusterHibernationStateStopControlPlane).(bool) {
+			params.StopControlPlane = lo.ToPtr(true)
+		}
+
+		resp, err := client.ExternalClusterAPITriggerHibernateClusterWithResponse(ctx, clusterID, params)
+		if err := sdk.CheckOKResponse(resp, err); err != nil {
+			return fmt.Errorf("triggering cluster hibernation: %w", err)
+		}
+		operationID = resp.JSON200.OperationId
+	case ClusterHibernationStateRunning:
+		nodeConfig := toSection(data, FieldClusterHibernationStateResumeNodeConfig)
+		if nodeConfig == nil {
+			return fmt.Errorf("%s is required to resume a hibernated cluster", FieldClusterHibernationStateResumeNodeConfig)
+		}
+
+		resp, err := client.ExternalClusterAPITriggerResumeClusterWithResponse(ctx, clusterID, &sdk.ExternalClusterAPITriggerResumeClusterParams{}, toResumeClusterNodeConfig(sectionToNodeConfig(nodeConfig)))
+		if err := sdk.CheckOKResponse(resp, err); err != nil {
+			return fmt.Errorf("triggering cluster resume: %w", err)
+		}
+		operationID = resp.JSON200.OperationId
+	}
+
+	tflog.Info(ctx, "waiting for cluster hibernation state change", map[string]any{"cluster_id": clusterID, "desired_state": desired, "operation_id": operationID})
+
+	return waitForOperation(ctx, client, operationID, timeout)
+}
+
+// clusterHibernationState maps the cluster status to the desired state it corresponds to.
+func clusterHibernationState(status string) string {
+	switch status {
+	case sdk.ClusterStatusHibernating, sdk.ClusterStatusHibernated:
+		return ClusterHibernationStateHibernated
+	default:
+		return ClusterHibernationStateRunning
+	}
+}
+
+func toResumeClusterNodeConfig(nodeConfig cluster_autoscaler.NodeConfig) sdk.ExternalclusterV1NodeConfig {
+	result := sdk.ExternalclusterV1NodeConfig{
+		InstanceType:      nodeConfig.InstanceType,
+		ConfigurationId:   nodeConfig.ConfigId,
+		ConfigurationName: nodeConfig.ConfigName,
+		SubnetId:          nodeConfig.SubnetId,
+		Zone:              nodeConfig.Zone,
+		KubernetesLabels:  nodeConfig.KubernetesLabels,
+	}
+
+	if nodeConfig.GpuConfig != nil {
+		result.GpuConfig = &sdk.ExternalclusterV1GPUConfig{
+			Count: nodeConfig.GpuConfig.Count,
+			Type:  nodeConfig.GpuConfig.Type,
+		}
+	}
+
+	if nodeConfig.NodeAffinity != nil {
+		result.NodeAffinity = &sdk.ExternalclusterV1NodeAffinity{
+			DedicatedGroup: nodeConfig.NodeAffinity.DedicatedGroup,
+		}
+		if nodeConfig.NodeAffinity.Affinity != nil {
+			result.NodeAffinity.Affinity = lo.ToPtr(lo.Map(*nodeConfig.NodeAffinity.Affinity, func(a cluster_autoscaler.KubernetesNodeAffinity, _ int) sdk.K8sSelectorV1KubernetesNodeAffinity {
+				return sdk.K8sSelectorV1KubernetesNodeAffinity{
+					Key:      a.Key,
+					Operator: sdk.K8sSelectorV1Operator(a.Operator),
+					Values:   a.Values,
+				}
+			}))
+		}
+	}
+
+	if nodeConfig.KubernetesTaints != nil {
+		result.KubernetesTaints = lo.ToPtr(lo.Map(*nodeConfig.KubernetesTaints, func(t cluster_autoscaler.Taint, _ int) sdk.ExternalclusterV1Taint {
+			return sdk.ExternalclusterV1Taint{
+				Effect: t.Effect,
+				Key:    t.Key,
+				Value:  t.Value,
+			}
+		}))
+	}
+
+	if nodeConfig.SpotConfig != nil {
+		result.SpotConfig = &sdk.ExternalclusterV1NodeSpotConfig{
+			IsSpot: nodeConfig.SpotConfig.Spot,
+			Price:  nodeConfig.SpotConfig.PriceHourly,
+		}
+	}
+
+	if nodeConfig.Volume != nil {
+		result.Volume = &sdk.ExternalclusterV1NodeVolume{
+			Size: nodeConfig.Volume.SizeGib,
+		}
+		if nodeConfig.Volume.RaidConfig != nil {
+			result.Volume.RaidConfig = &sdk.ExternalclusterV1RaidConfig{
+				ChunkSize: nodeConfig.Volume.RaidConfig.ChunkSizeKb,
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,179 @@
+package castai
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+	mock_sdk "github.com/castai/terraform-provider-castai/castai/sdk/mock"
+)
+
+func TestClusterHibernationStateResource(t *testing.T) {
+	t.Parallel()
+
+	clusterID := "b6bfc074-a267-400f-b8f1-db0850c36aa4"
+	operationID := "4a4b3b3e-6e4a-4b6e-9f0e-2c1a2b3c4d5e"
+
+	newResponse := func(body string) *http.Response {
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(body))), Header: map[string][]string{"Content-Type": {"json"}}}
+	}
+	clusterWithStatus := func(status string) *http.Response {
+		return newResponse(`{"id":"` + clusterID + `","status":"` + status + `"}`)
+	}
+	emptyNodeConfig := cty.ListValEmpty(hibernationNodeConfigResource.CoreConfigSchema().ImpliedType())
+
+	t.Run("hibernates running cluster and waits for operation", func(t *testing.T) {
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		gomock.InOrder(
+			mockClient.EXPECT().ExternalClusterAPIGetCluster(gomock.Any(), clusterID).Return(clusterWithStatus("ready"), nil),
+			mockClient.EXPECT().
+				ExternalClusterAPITriggerHibernateCluster(gomock.Any(), clusterID, &sdk.ExternalClusterAPITriggerHibernateClusterParams{StopControlPlane: lo.ToPtr(true)}).
+				Return(newResponse(`{"clusterId":"`+clusterID+`","operationId":"`+operationID+`"}`), nil),
+			mockClient.EXPECT().OperationsAPIGetOperation(gomock.Any(), operationID).Return(newResponse(`{"id":"`+operationID+`","done":false}`), nil),
+			mockClient.EXPECT().OperationsAPIGetOperation(gomock.Any(), operationID).Return(newResponse(`{"id":"`+operationID+`","done":true}`), nil),
+			mockClient.EXPECT().ExternalClusterAPIGetCluster(gomock.Any(), clusterID).Return(clusterWithStatus("hibernated"), nil),
+		)
+
+		resource := resourceClusterHibernationState()
+		state := sdkterraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{
+			FieldClusterID:                               cty.StringVal(clusterID),
+			FieldClusterHibernationStateDesiredState:     cty.StringVal(ClusterHibernationStateHibernated),
+			FieldClusterHibernationStateStopControlPlane: cty.True,
+			FieldClusterHibernationStateResumeNodeConfig: emptyNodeConfig,
+		}), 0)
+		data := resource.Data(state)
+
+		diags := resourceClusterHibernationStateCreate(context.Background(), data, provider)
+		r.Nil(diags)
+		r.Equal(clusterID, data.Id())
+		r.Equal(ClusterHibernationStateHibernated, data.Get(FieldClusterHibernationStateDesiredState))
+		r.Equal("hibernated", data.Get(FieldClusterHibernationStateStatus))
+	})
+
+	t.Run("resumes hibernated cluster with node config", func(t *testing.T) {
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		gomock.InOrder(
+			mockClient.EXPECT().ExternalClusterAPIGetCluster(gomock.Any(), clusterID).Return(clusterWithStatus("hibernated"), nil),
+			mockClient.EXPECT().
+				ExternalClusterAPITriggerResumeCluster(gomock.Any(), clusterID, &sdk.ExternalClusterAPITriggerResumeClusterParams{}, sdk.ExternalclusterV1NodeConfig{
+					InstanceType: "e2-standard-4",
+					Zone:         lo.ToPtr("europe-west1-b"),
+					SpotConfig: &sdk.ExternalclusterV1NodeSpotConfig{
+						IsSpot: lo.ToPtr(true),
+					},
+				}).
+				Return(newResponse(`{"clusterId":"`+clusterID+`","operationId":"`+operationID+`"}`), nil),
+			mockClient.EXPECT().OperationsAPIGetOperation(gomock.Any(), operationID).Return(newResponse(`{"id":"`+operationID+`","done":true}`), nil),
+			mockClient.EXPECT().ExternalClusterAPIGetCluster(gomock.Any(), clusterID).Return(clusterWithStatus("ready"), nil),
+		)
+
+		resource := resourceClusterHibernationState()
+		raw := map[string]any{
+			FieldClusterID:                           clusterID,
+			FieldClusterHibernationStateDesiredState: ClusterHibernationStateRunning,
+			FieldClusterHibernationStateResumeNodeConfig: []any{
+				map[string]any{
+					FieldHibernationScheduleInstanceType: "e2-standard-4",
+					FieldHibernationScheduleZone:         "europe-west1-b",
+					FieldHibernationScheduleSpotConfig: []any{
+						map[string]any{FieldHibernationScheduleSpot: true},
+					},
+				},
+			},
+		}
+		data := schema.TestResourceDataRaw(t, resource.Schema, raw)
+		data.SetId(clusterID)
+
+		diags := resourceClusterHibernationStateUpdate(context.Background(), data, provider)
+		r.Nil(diags)
+		r.Equal(ClusterHibernationStateRunning, data.Get(FieldClusterHibernationStateDesiredState))
+		r.Equal("ready", data.Get(FieldClusterHibernationStateStatus))
+	})
+
+	t.Run("fails to resume without node config", func(t *testing.T) {
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		mockClient.EXPECT().ExternalClusterAPIGetCluster(gomock.Any(), clusterID).Return(clusterWithStatus("hibernated"), nil)
+
+		resource := resourceClusterHibernationState()
+		state := sdkterraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{
+			FieldClusterID:                               cty.StringVal(clusterID),
+			FieldClusterHibernationStateDesiredState:     cty.StringVal(ClusterHibernationStateRunning),
+			FieldClusterHibernationStateResumeNodeConfig: emptyNodeConfig,
+		}), 0)
+		state.ID = clusterID
+		data := resource.Data(state)
+
+		diags := resourceClusterHibernationStateUpdate(context.Background(), data, provider)
+		r.True(diags.HasError())
+		r.Contains(diags[0].Summary, "resume_node_config is required")
+	})
+
+	t.Run("surfaces operation error", func(t *testing.T) {
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		gomock.InOrder(
+			mockClient.EXPECT().ExternalClusterAPIGetCluster(gomock.Any(), clusterID).Return(clusterWithStatus("ready"), nil),
+			mockClient.EXPECT().
+				ExternalClusterAPITriggerHibernateCluster(gomock.Any(), clusterID, &sdk.ExternalClusterAPITriggerHibernateClusterParams{}).
+				Return(newResponse(`{"clusterId":"`+clusterID+`","operationId":"`+operationID+`"}`), nil),
+			mockClient.EXPECT().
+				OperationsAPIGetOperation(gomock.Any(), operationID).
+				Return(newResponse(`{"id":"`+operationID+`","done":true,"error":{"reason":"PermissionDenied","details":"missing permissions"}}`), nil),
+		)
+
+		resource := resourceClusterHibernationState()
+		state := sdkterraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{
+			FieldClusterID:                               cty.StringVal(clusterID),
+			FieldClusterHibernationStateDesiredState:     cty.StringVal(ClusterHibernationStateHibernated),
+			FieldClusterHibernationStateResumeNodeConfig: emptyNodeConfig,
+		}), 0)
+		data := resource.Data(state)
+
+		diags := resourceClusterHibernationStateCreate(context.Background(), data, provider)
+		r.True(diags.HasError())
+		r.Contains(diags[0].Summary, "missing permissions")
+	})
+
+	t.Run("does nothing when cluster is already in desired state", func(t *testing.T) {
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		gomock.InOrder(
+			mockClient.EXPECT().ExternalClusterAPIGetCluster(gomock.Any(), clusterID).Return(clusterWithStatus("hibernated"), nil),
+			mockClient.EXPECT().ExternalClusterAPIGetCluster(gomock.Any(), clusterID).Return(clusterWithStatus("hibernated"), nil),
+		)
+
+		resource := resourceClusterHibernationState()
+		state := sdkterraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{
+			FieldClusterID:                               cty.StringVal(clusterID),
+			FieldClusterHibernationStateDesiredState:     cty.StringVal(ClusterHibernationStateHibernated),
+			FieldClusterHibernationStateResumeNodeConfig: emptyNodeConfig,
+		}), 0)
+		data := resource.Data(state)
+
+		diags := resourceClusterHibernationStateCreate(context.Background(), data, provider)
+		r.Nil(diags)
+	})
+}
@@ -0,0 +1,166 @@
+package castai
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+)
+
+const (
+	FieldClusterTagsTags      = "tags"
+	FieldClusterTagsExclusive = "exclusive"
+)
+
+func resourceClusterTags() *schema.Resource {
+	return &schema.Resource{
+		ReadContext:   resourceClusterTagsRead,
+		CreateContext: resourceClusterTagsCreate,
+		UpdateContext: resourceClusterTagsUpdate,
+		DeleteContext: resourceClusterTagsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: clusterTagsStateImporter,
+		},
+		Description: "Manages tags of a cluster connected to CAST AI. Works for EKS, GKE, AKS and Omni clusters alike.",
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			FieldClusterID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsUUID),
+				Description:      "CAST AI cluster id.",
+			},
+			FieldClusterTagsTags: {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Tags of the cluster.",
+			},
+			FieldClusterTagsExclusive: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				Description: "When true, the resource manages the full tag map of the cluster and removes tags which are not " +
+					"configured. When false, only the configured keys are managed and tags set elsewhere are left untouched.",
+			},
+		},
+	}
+}
+
+func resourceClusterTagsRead(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+
+	resp, err := fetchClusterData(ctx, client, data.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("getting cluster: %w", err))
+	}
+	if resp == nil {
+		data.SetId("")
+		return nil
+	}
+
+	remote := lo.FromPtr(resp.JSON200.Tags)
+	tags := remote
+	if !data.Get(FieldClusterTagsExclusive).(bool) {
+		// Only keep the keys managed by this resource, so that tags set elsewhere don't show up as drift.
+		managed := toStringMap(data.Get(FieldClusterTagsTags).(map[string]any))
+		tags = lo.PickByKeys(remote, lo.Keys(managed))
+	}
+
+	if err := data.Set(FieldClusterID, data.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("setting cluster id: %w", err))
+	}
+	if err := data.Set(FieldClusterTagsTags, tags); err != nil {
+		return diag.FromErr(fmt.Errorf("setting tags: %w", err))
+	}
+
+	return nil
+}
+
+func resourceClusterTagsCreate(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
+	clusterID := data.Get(FieldClusterID).(string)
+
+	tags := toStringMap(data.Get(FieldClusterTagsTags).(map[string]any))
+	if err := updateClusterTags(ctx, meta, clusterID, data.Get(FieldClusterTagsExclusive).(bool), tags, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(clusterID)
+
+	return resourceClusterTagsRead(ctx, data, meta)
+}
+
+func resourceClusterTagsUpdate(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
+	oldTags, newTags := data.GetChange(FieldClusterTagsTags)
+
+	tags := toStringMap(newTags.(map[string]any))
+	removed := lo.Without(lo.Keys(oldTags.(map[string]any)), lo.Keys(tags)...)
+	if err := updateClusterTags(ctx, meta, data.Id(), data.Get(FieldClusterTagsExclusive).(bool), tags, removed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceClusterTagsRead(ctx, data, meta)
+}
+
+func resourceClusterTagsDelete(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
+	managed := lo.Keys(data.Get(FieldClusterTagsTags).(map[string]any))
+	if err := updateClusterTags(ctx, meta, data.Id(), data.Get(FieldClusterTagsExclusive).(bool), nil, managed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// updateClusterTags sets the tags of the cluster. In exclusive mode the cluster ends up with exactly the given tags.
+// Otherwise the given tags are merged into the current ones and the removed keys are dropped, leaving other tags intact.
+func updateClusterTags(ctx context.Context, meta any, clusterID string, exclusive bool, tags map[string]string, removed []string) error {
+	client := meta.(*ProviderConfig).api
+
+	desired := map[string]string{}
+	if !exclusive {
+		resp, err := fetchClusterData(ctx, client, clusterID)
+		if err != nil {
+			return fmt.Errorf("getting cluster: %w", err)
+		}
+		if resp == nil {
+			return fmt.Errorf("cluster %s not found", clusterID)
+		}
+		desired = lo.OmitByKeys(lo.FromPtr(resp.JSON200.Tags), removed)
+	}
+	for k, v := range tags {
+		desired[k] = v
+	}
+
+	tflog.Debug(ctx, "updating cluster tags", map[string]any{"cluster_id": clusterID, "tags": desired})
+
+	resp, err := client.ExternalClusterAPIUpdateClusterTagsWithResponse(ctx, clusterID, desired)
+	if err := sdk.CheckOKResponse(resp, err); err != nil {
+		return fmt.Errorf("updating cluster tags: %w", err)
+	}
+
+	return nil
+}
+
+func clusterTagsStateImporter(ctx context.Context, data *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if err := data.Set(FieldClusterID, data.Id()); err != nil {
+		return nil, err
+	}
+	if err := data.Set(FieldClusterTagsExclusive, true); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{data}, nil
+}
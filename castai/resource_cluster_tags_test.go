@@ -0,0 +1,183 @@
+package castai
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+	mock_sdk "github.com/castai/terraform-provider-castai/castai/sdk/mock"
+)
+
+func TestClusterTagsResource(t *testing.T) {
+	t.Parallel()
+
+	clusterID := "b6bfc074-a267-400f-b8f1-db0850c36aa4"
+	clusterBody := `{"id":"b6bfc074-a267-400f-b8f1-db0850c36aa4","status":"ready","tags":{"env":"prod","team":"data","owner":"platform"}}`
+
+	newResponse := func(body string) *http.Response {
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(body))), Header: map[string][]string{"Content-Type": {"json"}}}
+	}
+	newData := func(exclusive bool, tags map[string]string) *schema.ResourceData {
+		tagVals := map[string]cty.Value{}
+		for k, v := range tags {
+			tagVals[k] = cty.StringVal(v)
+		}
+		state := sdkterraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{
+			FieldClusterID:            cty.StringVal(clusterID),
+			FieldClusterTagsTags:      cty.MapVal(tagVals),
+			FieldClusterTagsExclusive: cty.BoolVal(exclusive),
+		}), 0)
+		state.ID = clusterID
+		return resourceClusterTags().Data(state)
+	}
+
+	t.Run("read in exclusive mode returns all tags", func(t *testing.T) {
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		mockClient.EXPECT().ExternalClusterAPIGetCluster(gomock.Any(), clusterID).Return(newResponse(clusterBody), nil)
+
+		data := newData(true, map[string]string{"env": "prod"})
+		diags := resourceClusterTagsRead(context.Background(), data, provider)
+		r.Nil(diags)
+		r.Equal(map[string]any{"env": "prod", "team": "data", "owner": "platform"}, data.Get(FieldClusterTagsTags))
+	})
+
+	t.Run("read in non-exclusive mode returns only managed keys", func(t *testing.T) {
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		mockClient.EXPECT().ExternalClusterAPIGetCluster(gomock.Any(), clusterID).Return(newResponse(clusterBody), nil)
+
+		data := newData(false, map[string]string{"env": "staging", "cost-center": "123"})
+		diags := resourceClusterTagsRead(context.Background(), data, provider)
+		r.Nil(diags)
+		r.Equal(map[string]any{"env": "prod"}, data.Get(FieldClusterTagsTags))
+	})
+
+	t.Run("read removes resource when cluster is gone", func(t *testing.T) {
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		mockClient.EXPECT().ExternalClusterAPIGetCluster(gomock.Any(), clusterID).
+			Return(&http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(bytes.NewReader([]byte(`{}`))), Header: map[string][]string{"Content-Type": {"json"}}}, nil)
+
+		data := newData(true, map[string]string{"env": "prod"})
+		diags := resourceClusterTagsRead(context.Background(), data, provider)
+		r.Nil(diags)
+		r.Empty(data.Id())
+	})
+
+	t.Run("create in exclusive mode replaces all tags", func(t *testing.T) {
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		gomock.InOrder(
+			mockClient.EXPECT().
+				ExternalClusterAPIUpdateClusterTags(gomock.Any(), clusterID, sdk.ExternalClusterAPIUpdateClusterTagsJSONRequestBody{"env": "prod"}).
+				Return(newResponse(`{}`), nil),
+			mockClient.EXPECT().
+				ExternalClusterAPIGetCluster(gomock.Any(), clusterID).
+				Return(newResponse(`{"id":"b6bfc074-a267-400f-b8f1-db0850c36aa4","status":"ready","tags":{"env":"prod"}}`), nil),
+		)
+
+		data := newData(true, map[string]string{"env": "prod"})
+		data.SetId("")
+		diags := resourceClusterTagsCreate(context.Background(), data, provider)
+		r.Nil(diags)
+		r.Equal(clusterID, data.Id())
+		r.Equal(map[string]any{"env": "prod"}, data.Get(FieldClusterTagsTags))
+	})
+
+	t.Run("create in non-exclusive mode keeps tags set elsewhere", func(t *testing.T) {
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		gomock.InOrder(
+			mockClient.EXPECT().ExternalClusterAPIGetCluster(gomock.Any(), clusterID).Return(newResponse(clusterBody), nil),
+			mockClient.EXPECT().
+				ExternalClusterAPIUpdateClusterTags(gomock.Any(), clusterID, sdk.ExternalClusterAPIUpdateClusterTagsJSONRequestBody{
+					"env":         "staging",
+					"team":        "data",
+					"owner":       "platform",
+					"cost-center": "123",
+				}).
+				Return(newResponse(`{}`), nil),
+			mockClient.EXPECT().
+				ExternalClusterAPIGetCluster(gomock.Any(), clusterID).
+				Return(newResponse(`{"id":"b6bfc074-a267-400f-b8f1-db0850c36aa4","status":"ready","tags":{"env":"staging","team":"data","owner":"platform","cost-center":"123"}}`), nil),
+		)
+
+		data := newData(false, map[string]string{"env": "staging", "cost-center": "123"})
+		data.SetId("")
+		diags := resourceClusterTagsCreate(context.Background(), data, provider)
+		r.Nil(diags)
+		r.Equal(map[string]any{"env": "staging", "cost-center": "123"}, data.Get(FieldClusterTagsTags))
+	})
+
+	t.Run("delete in non-exclusive mode removes only managed keys", func(t *testing.T) {
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		gomock.InOrder(
+			mockClient.EXPECT().ExternalClusterAPIGetCluster(gomock.Any(), clusterID).Return(newResponse(clusterBody), nil),
+			mockClient.EXPECT().
+				ExternalClusterAPIUpdateClusterTags(gomock.Any(), clusterID, sdk.ExternalClusterAPIUpdateClusterTagsJSONRequestBody{"owner": "platform"}).
+				Return(newResponse(`{}`), nil),
+		)
+
+		data := newData(false, map[string]string{"env": "prod", "team": "data"})
+		diags := resourceClusterTagsDelete(context.Background(), data, provider)
+		r.Nil(diags)
+	})
+
+	t.Run("delete in exclusive mode clears all tags", func(t *testing.T) {
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		mockClient.EXPECT().
+			ExternalClusterAPIUpdateClusterTags(gomock.Any(), clusterID, sdk.ExternalClusterAPIUpdateClusterTagsJSONRequestBody{}).
+			Return(newResponse(`{}`), nil)
+
+		data := newData(true, map[string]string{"env": "prod"})
+		diags := resourceClusterTagsDelete(context.Background(), data, provider)
+		r.Nil(diags)
+	})
+}
+
+func TestClusterTagsResource_Import(t *testing.T) {
+	t.Parallel()
+
+	r := require.New(t)
+	mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+	provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+	clusterID := "b6bfc074-a267-400f-b8f1-db0850c36aa4"
+
+	resource := resourceClusterTags()
+	state := sdkterraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{}), 0)
+	state.ID = clusterID
+	data := resource.Data(state)
+
+	result, err := resource.Importer.StateContext(context.Background(), data, provider)
+	r.NoError(err)
+	r.Len(result, 1)
+	r.Equal(clusterID, result[0].Get(FieldClusterID))
+	r.Equal(true, result[0].Get(FieldClusterTagsExclusive))
+}
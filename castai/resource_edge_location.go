@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
@@ -921,23 +920,16 @@ func (r *edgeLocationResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *edgeLocationResource) waitForDeletion(ctx context.Context, client omni.ClientWithResponsesInterface, organizationID, clusterID, edgeLocationID string) error {
-	ticker := time.NewTicker(5 * time.Second)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-ticker.C:
-			resp, err := client.EdgeLocationsAPIGetEdgeLocationWithResponse(ctx, organizationID, clusterID, edgeLocationID)
-			if err != nil {
-				return fmt.Errorf("polling edge location status: %w", err)
-			}
-			if resp.StatusCode() == http.StatusNotFound {
-				return nil
-			}
+	return waitFor(ctx, fmt.Sprintf("edge location %s deletion", edgeLocationID), 0, func(ctx context.Context) (bool, string, error) {
+		resp, err := client.EdgeLocationsAPIGetEdgeLocationWithResponse(ctx, organizationID, clusterID, edgeLocationID)
+		if err != nil {
+			return false, "", fmt.Errorf("polling edge location status: %w", err)
 		}
-	}
+		if resp.StatusCode() == http.StatusNotFound {
+			return true, "deleted", nil
+		}
+		return false, "deleting", nil
+	})
 }
 
 func (r *edgeLocationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
@@ -26,7 +26,10 @@ func resourceEKSCluster() *schema.Resource {
 		ReadContext:   resourceCastaiEKSClusterRead,
 		UpdateContext: resourceCastaiEKSClusterUpdate,
 		DeleteContext: resourceCastaiClusterDelete,
-		Description:   "EKS cluster resource allows connecting an existing EKS cluster to CAST AI.",
+		Importer: &schema.ResourceImporter{
+			StateContext: clusterStateImporter(importEKSClusterFields),
+		},
+		Description: "EKS cluster resource allows connecting an existing EKS cluster to CAST AI.",
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
@@ -213,6 +216,27 @@ func updateClusterSettings(ctx context.Context, data *schema.ResourceData, clien
 	return resourceCastaiClusterUpdate(ctx, client, data, &req)
 }
 
+func importEKSClusterFields(cluster *sdk.ExternalclusterV1Cluster, data *schema.ResourceData) error {
+	if cluster.Eks == nil {
+		return fmt.Errorf("cluster %s is not an EKS cluster", data.Id())
+	}
+
+	if err := data.Set(FieldEKSClusterName, toString(cluster.Eks.ClusterName)); err != nil {
+		return fmt.Errorf("setting cluster name: %w", err)
+	}
+	if err := data.Set(FieldEKSClusterAccountId, toString(cluster.Eks.AccountId)); err != nil {
+		return fmt.Errorf("setting account id: %w", err)
+	}
+	if err := data.Set(FieldEKSClusterRegion, toString(cluster.Eks.Region)); err != nil {
+		return fmt.Errorf("setting region: %w", err)
+	}
+	if err := data.Set(FieldEKSClusterAssumeRoleArn, toString(cluster.Eks.AssumeRoleArn)); err != nil {
+		return fmt.Errorf("setting assume role arn: %w", err)
+	}
+
+	return nil
+}
+
 func getOptionalBool(data *schema.ResourceData, field string, defaultValue bool) *bool {
 	del, ok := data.GetOk(field)
 	if ok {
@@ -439,3 +439,81 @@ func TestEKSClusterResourceUpdateRetry(t *testing.T) {
 	result := resource.UpdateContext(ctx, data, provider)
 	r.Nil(result)
 }
+
+func TestEKSClusterResourceImport(t *testing.T) {
+	r := require.New(t)
+	mockctrl := gomock.NewController(t)
+	mockClient := mock_sdk.NewMockClientInterface(mockctrl)
+
+	ctx := context.Background()
+	provider := &ProviderConfig{
+		api: &sdk.ClientWithResponses{
+			ClientInterface: mockClient,
+		},
+	}
+
+	clusterId := "b6bfc074-a267-400f-b8f1-db0850c369b1"
+	clusterResponse := `{
+  "id": "b6bfc074-a267-400f-b8f1-db0850c369b1",
+  "name": "eks-cluster",
+  "organizationId": "2836f775-aaaa-eeee-bbbb-3d3c29512692",
+  "credentialsId": "9b8d0456-177b-4a3d-b162-e68030d656aa",
+  "status": "ready",
+  "agentStatus": "online",
+  "providerType": "eks",
+  "eks": {
+    "clusterName": "eks-cluster",
+    "region": "eu-central-1",
+    "accountId": "487609000000",
+    "assumeRoleArn": "arn:aws:iam::487609000000:role/castai"
+  }
+}`
+	mockClient.EXPECT().
+		ExternalClusterAPIGetCluster(gomock.Any(), clusterId).
+		DoAndReturn(func(_ context.Context, _ string, _ ...sdk.RequestEditorFn) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(clusterResponse))), Header: map[string][]string{"Content-Type": {"json"}}}, nil
+		}).Times(2)
+
+	resource := resourceEKSCluster()
+	state := terraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{}), 0)
+	state.ID = clusterId
+	data := resource.Data(state)
+
+	imported, err := resource.Importer.StateContext(ctx, data, provider)
+	r.NoError(err)
+	r.Len(imported, 1)
+
+	result := resource.ReadContext(ctx, imported[0], provider)
+	r.Nil(result)
+	r.Equal("eks-cluster", imported[0].Get(FieldEKSClusterName))
+	r.Equal("487609000000", imported[0].Get(FieldEKSClusterAccountId))
+	r.Equal("eu-central-1", imported[0].Get(FieldEKSClusterRegion))
+	r.Equal("arn:aws:iam::487609000000:role/castai", imported[0].Get(FieldEKSClusterAssumeRoleArn))
+	r.Equal("9b8d0456-177b-4a3d-b162-e68030d656aa", imported[0].Get(FieldClusterCredentialsId))
+}
+
+func TestEKSClusterResourceImportWrongProvider(t *testing.T) {
+	r := require.New(t)
+	mockctrl := gomock.NewController(t)
+	mockClient := mock_sdk.NewMockClientInterface(mockctrl)
+
+	ctx := context.Background()
+	provider := &ProviderConfig{
+		api: &sdk.ClientWithResponses{
+			ClientInterface: mockClient,
+		},
+	}
+
+	clusterId := "b6bfc074-a267-400f-b8f1-db0850c369b1"
+	body := io.NopCloser(bytes.NewReader([]byte(`{"id": "b6bfc074-a267-400f-b8f1-db0850c369b1", "status": "ready", "gke": {"clusterName": "gke"}}`)))
+	mockClient.EXPECT().
+		ExternalClusterAPIGetCluster(gomock.Any(), clusterId).
+		Return(&http.Response{StatusCode: 200, Body: body, Header: map[string][]string{"Content-Type": {"json"}}}, nil)
+
+	resource := resourceEKSCluster()
+	state := terraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{}), 0)
+	state.ID = clusterId
+
+	_, err := resource.Importer.StateContext(ctx, resource.Data(state), provider)
+	r.EqualError(err, "cluster b6bfc074-a267-400f-b8f1-db0850c369b1 is not an EKS cluster")
+}
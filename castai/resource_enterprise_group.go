@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -102,7 +103,10 @@ func resourceEnterpriseGroup() *schema.Resource {
 		ReadContext:   resourceEnterpriseGroupRead,
 		UpdateContext: resourceEnterpriseGroupUpdate,
 		DeleteContext: resourceEnterpriseGroupDelete,
-		Description:   "CAST AI Enterprise Group resource.",
+		Importer: &schema.ResourceImporter{
+			StateContext: enterpriseGroupStateImporter,
+		},
+		Description: "CAST AI Enterprise Group resource.",
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(2 * time.Minute),
 			Update: schema.DefaultTimeout(2 * time.Minute),
@@ -277,6 +281,65 @@ func resourceEnterpriseGroupCreate(ctx context.Context, data *schema.ResourceDat
 	return nil
 }
 
+// enterpriseGroupStateImporter imports a group by ID or name. The enterprise can be given in format
+// <enterprise id>/<group id|group name>, otherwise the default organization of the API token is used.
+func enterpriseGroupStateImporter(ctx context.Context, data *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*ProviderConfig).organizationManagementClient
+
+	enterpriseID, id := parseImportID(data)
+	if enterpriseID == "" {
+		defaultOrganizationID, err := getDefaultOrganizationId(ctx, meta)
+		if err != nil {
+			return nil, err
+		}
+		enterpriseID = defaultOrganizationID
+	}
+
+	params := &organization_management.EnterpriseAPIListGroupsParams{}
+	if _, err := uuid.Parse(id); err != nil {
+		tflog.Info(ctx, "provided group ID is not a UUID, will import by name")
+		params.GroupName = lo.ToPtr(id)
+	}
+
+	var groups []organization_management.ListGroupsResponseGroup
+	for {
+		resp, err := client.EnterpriseAPIListGroupsWithResponse(ctx, enterpriseID, params)
+		if err := sdk.CheckOKResponse(resp, err); err != nil {
+			return nil, fmt.Errorf("list enterprise groups failed: %w", err)
+		}
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected empty response from list enterprise groups")
+		}
+		for _, group := range lo.FromPtr(resp.JSON200.Items) {
+			if lo.FromPtr(group.Id) == id || lo.FromPtr(group.Name) == id {
+				groups = append(groups, group)
+			}
+		}
+		if resp.JSON200.NextPageCursor == nil || *resp.JSON200.NextPageCursor == "" {
+			break
+		}
+		params.PageCursor = resp.JSON200.NextPageCursor
+	}
+
+	switch {
+	case len(groups) == 0:
+		return nil, fmt.Errorf("could not find enterprise group %q in enterprise %s", id, enterpriseID)
+	case len(groups) > 1:
+		return nil, fmt.Errorf("found %d enterprise groups named %q in enterprise %s, import by group ID instead", len(groups), id, enterpriseID)
+	}
+
+	groupID := lo.FromPtr(groups[0].Id)
+	data.SetId(groupID)
+	if err := data.Set(FieldEnterpriseGroupEnterpriseID, enterpriseID); err != nil {
+		return nil, err
+	}
+	if err := data.Set(FieldEnterpriseGroupID, groupID); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{data}, nil
+}
+
 func buildBatchCreateRequest(
 	enterpriseID string,
 	data *schema.ResourceData,
@@ -1265,3 +1265,132 @@ func TestResourceEnterpriseGroupUpdateContext(t *testing.T) {
 		r.Equal(organizationID, scope2a1[FieldEnterpriseGroupScopeOrganization])
 	})
 }
+
+func TestResourceEnterpriseGroupImport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when importing by name then resolve group ID", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mockOrganizationManagement.NewMockClientWithResponsesInterface(gomock.NewController(t))
+
+		ctx := context.Background()
+		provider := &ProviderConfig{
+			organizationManagementClient: mockClient,
+		}
+
+		enterpriseID := uuid.NewString()
+		groupID := uuid.NewString()
+
+		mockClient.EXPECT().
+			EnterpriseAPIListGroupsWithResponse(gomock.Any(), enterpriseID, &organization_management.EnterpriseAPIListGroupsParams{
+				GroupName: lo.ToPtr("managed-group"),
+			}).
+			Return(&organization_management.EnterpriseAPIListGroupsResponse{
+				HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+				JSON200: &organization_management.ListGroupsResponse{
+					Items: &[]organization_management.ListGroupsResponseGroup{
+						{Id: lo.ToPtr(groupID), Name: lo.ToPtr("managed-group")},
+					},
+				},
+			}, nil)
+
+		state := terraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{}), 0)
+		state.ID = enterpriseID + "/managed-group"
+
+		resource := resourceEnterpriseGroup()
+		data := resource.Data(state)
+
+		result, err := resource.Importer.StateContext(ctx, data, provider)
+		r.NoError(err)
+		r.Len(result, 1)
+		r.Equal(groupID, result[0].Id())
+		r.Equal(groupID, result[0].Get(FieldEnterpriseGroupID))
+		r.Equal(enterpriseID, result[0].Get(FieldEnterpriseGroupEnterpriseID))
+	})
+
+	t.Run("when group is on a later page then follow page cursor", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mockOrganizationManagement.NewMockClientWithResponsesInterface(gomock.NewController(t))
+
+		ctx := context.Background()
+		provider := &ProviderConfig{
+			organizationManagementClient: mockClient,
+		}
+
+		enterpriseID := uuid.NewString()
+		groupID := uuid.NewString()
+
+		gomock.InOrder(
+			mockClient.EXPECT().
+				EnterpriseAPIListGroupsWithResponse(gomock.Any(), enterpriseID, &organization_management.EnterpriseAPIListGroupsParams{}).
+				Return(&organization_management.EnterpriseAPIListGroupsResponse{
+					HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+					JSON200: &organization_management.ListGroupsResponse{
+						Items: &[]organization_management.ListGroupsResponseGroup{
+							{Id: lo.ToPtr(uuid.NewString()), Name: lo.ToPtr("other-group")},
+						},
+						NextPageCursor: lo.ToPtr("page-2"),
+					},
+				}, nil),
+			mockClient.EXPECT().
+				EnterpriseAPIListGroupsWithResponse(gomock.Any(), enterpriseID, &organization_management.EnterpriseAPIListGroupsParams{
+					PageCursor: lo.ToPtr("page-2"),
+				}).
+				Return(&organization_management.EnterpriseAPIListGroupsResponse{
+					HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+					JSON200: &organization_management.ListGroupsResponse{
+						Items: &[]organization_management.ListGroupsResponseGroup{
+							{Id: lo.ToPtr(groupID), Name: lo.ToPtr("managed-group")},
+						},
+					},
+				}, nil),
+		)
+
+		state := terraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{}), 0)
+		state.ID = enterpriseID + "/" + groupID
+
+		resource := resourceEnterpriseGroup()
+		data := resource.Data(state)
+
+		result, err := resource.Importer.StateContext(ctx, data, provider)
+		r.NoError(err)
+		r.Len(result, 1)
+		r.Equal(groupID, result[0].Id())
+	})
+
+	t.Run("when group is not found then return error", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mockOrganizationManagement.NewMockClientWithResponsesInterface(gomock.NewController(t))
+
+		ctx := context.Background()
+		provider := &ProviderConfig{
+			organizationManagementClient: mockClient,
+		}
+
+		enterpriseID := uuid.NewString()
+		groupID := uuid.NewString()
+
+		mockClient.EXPECT().
+			EnterpriseAPIListGroupsWithResponse(gomock.Any(), enterpriseID, &organization_management.EnterpriseAPIListGroupsParams{}).
+			Return(&organization_management.EnterpriseAPIListGroupsResponse{
+				HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+				JSON200: &organization_management.ListGroupsResponse{
+					Items: &[]organization_management.ListGroupsResponseGroup{
+						{Id: lo.ToPtr(uuid.NewString()), Name: lo.ToPtr("other-group")},
+					},
+				},
+			}, nil)
+
+		state := terraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{}), 0)
+		state.ID = enterpriseID + "/" + groupID
+
+		resource := resourceEnterpriseGroup()
+		data := resource.Data(state)
+
+		_, err := resource.Importer.StateContext(ctx, data, provider)
+		r.ErrorContains(err, "could not find enterprise group")
+	})
+}
@@ -29,7 +29,10 @@ func resourceEnterpriseServiceAccount() *schema.Resource {
 		ReadContext:   resourceEnterpriseServiceAccountRead,
 		UpdateContext: resourceEnterpriseServiceAccountUpdate,
 		DeleteContext: resourceEnterpriseServiceAccountDelete,
-		Description:   "CAST AI Enterprise Service Account resource.",
+		Importer: &schema.ResourceImporter{
+			StateContext: enterpriseServiceAccountStateImporter,
+		},
+		Description: "CAST AI Enterprise Service Account resource.",
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(2 * time.Minute),
@@ -160,6 +163,69 @@ func resourceEnterpriseServiceAccountRead(ctx context.Context, data *schema.Reso
 	return nil
 }
 
+// enterpriseServiceAccountStateImporter imports a service account by ID or name. The enterprise can be given in format
+// <enterprise id>/<service account id|service account name>, otherwise the default organization of the API token is used.
+func enterpriseServiceAccountStateImporter(ctx context.Context, data *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*ProviderConfig).organizationManagementClient
+
+	enterpriseID, id := parseImportID(data)
+	if enterpriseID == "" {
+		defaultOrganizationID, err := getDefaultOrganizationId(ctx, meta)
+		if err != nil {
+			return nil, err
+		}
+		enterpriseID = defaultOrganizationID
+	}
+
+	var matches []organization_management.ListEnterpriseServiceAccountsResponseServiceAccount
+	var cursor *string
+	for {
+		resp, err := client.EnterpriseAPIListEnterpriseServiceAccountsWithResponse(
+			ctx,
+			enterpriseID,
+			&organization_management.EnterpriseAPIListEnterpriseServiceAccountsParams{
+				PageCursor: cursor,
+			},
+		)
+		if err := sdk.CheckOKResponse(resp, err); err != nil {
+			return nil, fmt.Errorf("list enterprise service accounts failed: %w", err)
+		}
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected empty response from list enterprise service accounts")
+		}
+		for _, item := range lo.FromPtr(resp.JSON200.Items) {
+			if lo.FromPtr(item.Id) == id || lo.FromPtr(item.Name) == id {
+				matches = append(matches, item)
+			}
+		}
+		if resp.JSON200.NextPageCursor == nil || *resp.JSON200.NextPageCursor == "" {
+			break
+		}
+		cursor = resp.JSON200.NextPageCursor
+	}
+
+	switch {
+	case len(matches) == 0:
+		return nil, fmt.Errorf("could not find enterprise service account %q in enterprise %s", id, enterpriseID)
+	case len(matches) > 1:
+		return nil, fmt.Errorf("found %d enterprise service accounts named %q in enterprise %s, import by service account ID instead", len(matches), id, enterpriseID)
+	}
+
+	data.SetId(lo.FromPtr(matches[0].Id))
+	if err := data.Set(FieldEnterpriseServiceAccountEnterpriseID, enterpriseID); err != nil {
+		return nil, err
+	}
+	organizationID := lo.FromPtr(matches[0].OrganizationId)
+	if organizationID == "" {
+		organizationID = enterpriseID
+	}
+	if err := data.Set(FieldEnterpriseServiceAccountOrganizationID, organizationID); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{data}, nil
+}
+
 func resourceEnterpriseServiceAccountCreate(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*ProviderConfig).organizationManagementClient
 
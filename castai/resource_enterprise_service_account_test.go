@@ -803,3 +803,61 @@ func TestEnterpriseServiceAccountDeleteContext(t *testing.T) {
 		r.Empty(data.Id())
 	})
 }
+
+func TestEnterpriseServiceAccountImport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when importing by name then follow pages and resolve service account", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mockOrganizationManagement.NewMockClientWithResponsesInterface(gomock.NewController(t))
+
+		ctx := context.Background()
+		provider := &ProviderConfig{
+			organizationManagementClient: mockClient,
+		}
+
+		enterpriseID := uuid.NewString()
+		organizationID := uuid.NewString()
+		serviceAccountID := uuid.NewString()
+
+		gomock.InOrder(
+			mockClient.EXPECT().
+				EnterpriseAPIListEnterpriseServiceAccountsWithResponse(gomock.Any(), enterpriseID, &organization_management.EnterpriseAPIListEnterpriseServiceAccountsParams{}).
+				Return(&organization_management.EnterpriseAPIListEnterpriseServiceAccountsResponse{
+					HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+					JSON200: &organization_management.ListEnterpriseServiceAccountsResponse{
+						Items: &[]organization_management.ListEnterpriseServiceAccountsResponseServiceAccount{
+							{Id: lo.ToPtr(uuid.NewString()), Name: lo.ToPtr("other"), OrganizationId: lo.ToPtr(organizationID)},
+						},
+						NextPageCursor: lo.ToPtr("next"),
+					},
+				}, nil),
+			mockClient.EXPECT().
+				EnterpriseAPIListEnterpriseServiceAccountsWithResponse(gomock.Any(), enterpriseID, &organization_management.EnterpriseAPIListEnterpriseServiceAccountsParams{
+					PageCursor: lo.ToPtr("next"),
+				}).
+				Return(&organization_management.EnterpriseAPIListEnterpriseServiceAccountsResponse{
+					HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+					JSON200: &organization_management.ListEnterpriseServiceAccountsResponse{
+						Items: &[]organization_management.ListEnterpriseServiceAccountsResponseServiceAccount{
+							{Id: lo.ToPtr(serviceAccountID), Name: lo.ToPtr("ci"), OrganizationId: lo.ToPtr(organizationID)},
+						},
+					},
+				}, nil),
+		)
+
+		state := terraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{}), 0)
+		state.ID = enterpriseID + "/ci"
+
+		resource := resourceEnterpriseServiceAccount()
+		data := resource.Data(state)
+
+		result, err := resource.Importer.StateContext(ctx, data, provider)
+		r.NoError(err)
+		r.Len(result, 1)
+		r.Equal(serviceAccountID, result[0].Id())
+		r.Equal(enterpriseID, result[0].Get(FieldEnterpriseServiceAccountEnterpriseID))
+		r.Equal(organizationID, result[0].Get(FieldEnterpriseServiceAccountOrganizationID))
+	})
+}
@@ -6,8 +6,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"reflect"
+	"sort"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -41,7 +44,10 @@ func resourceEvictionConfig() *schema.Resource {
 		CreateContext: resourceEvictionConfigCreate,
 		UpdateContext: resourceEvictionConfigUpdate,
 		DeleteContext: resourceEvictionConfigDelete,
-		Description:   "CAST AI eviction config resource to manage evictor properties ",
+		Importer: &schema.ResourceImporter{
+			StateContext: evictionConfigStateImporter,
+		},
+		Description: "CAST AI eviction config resource to manage evictor properties ",
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(2 * time.Minute),
@@ -55,9 +61,10 @@ func resourceEvictionConfig() *schema.Resource {
 				Description:      "CAST AI cluster id.",
 			},
 			FieldEvictorAdvancedConfig: {
-				Type:        schema.TypeList,
-				Description: "evictor advanced configuration to target specific node/pod",
-				Required:    true,
+				Type:             schema.TypeList,
+				Description:      "evictor advanced configuration to target specific node/pod",
+				Required:         true,
+				DiffSuppressFunc: suppressEvictionConfigReorder,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						FieldPodSelector: {
@@ -171,9 +178,9 @@ func readAdvancedEvictorConfig(ctx context.Context, data *schema.ResourceData, m
 	client := meta.(*ProviderConfig).api
 
 	resp, err := client.EvictorAPIGetAdvancedConfigWithResponse(ctx, clusterId)
-	if err != nil {
-		log.Printf("[ERROR] Failed to set read evictor advanced config: %v", err)
-		return err
+	if checkErr := sdk.CheckOKResponse(resp, err); checkErr != nil {
+		log.Printf("[ERROR] Failed to read evictor advanced config: %v", checkErr)
+		return checkErr
 	}
 	err = data.Set(FieldEvictorAdvancedConfig, flattenEvictionConfig(resp.JSON200.EvictionConfig))
 	if err != nil {
@@ -184,6 +191,135 @@ func readAdvancedEvictorConfig(ctx context.Context, data *schema.ResourceData, m
 	return nil
 }
 
+func evictionConfigStateImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	clusterID := d.Id()
+	if _, err := uuid.Parse(clusterID); err != nil {
+		return nil, fmt.Errorf("expected cluster_id to be a valid UUID, got: %q", clusterID)
+	}
+
+	if err := d.Set(FieldClusterId, clusterID); err != nil {
+		return nil, fmt.Errorf("setting cluster_id: %w", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// suppressEvictionConfigReorder suppresses the diff of evictor_advanced_config when both the state and the
+// configuration hold the same eviction configs, only in a different order. It is called for every nested key.
+func suppressEvictionConfigReorder(_, _, _ string, d *schema.ResourceData) bool {
+	oldValue, newValue := d.GetChange(FieldEvictorAdvancedConfig)
+
+	oldKeys, err := evictionConfigKeys(oldValue)
+	if err != nil {
+		return false
+	}
+	newKeys, err := evictionConfigKeys(newValue)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(oldKeys, newKeys)
+}
+
+// evictionConfigKeys returns sorted canonical representations of eviction configs held in resource data.
+func evictionConfigKeys(in interface{}) ([]string, error) {
+	configs, err := toEvictionConfig(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return sortedEvictionConfigKeys(configs)
+}
+
+func sortedEvictionConfigKeys(configs []sdk.CastaiEvictorV1EvictionConfig) ([]string, error) {
+	keys := make([]string, len(configs))
+	for i, config := range configs {
+		var err error
+		if keys[i], err = evictionConfigKey(config); err != nil {
+			return nil, err
+		}
+	}
+	sort.Strings(keys)
+
+	return keys, nil
+}
+
+// evictionConfigKey returns a canonical representation of an eviction config, in which empty values are dropped
+// and match expressions and their values are sorted. The given config is left untouched.
+func evictionConfigKey(config sdk.CastaiEvictorV1EvictionConfig) (string, error) {
+	raw, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	var canonical sdk.CastaiEvictorV1EvictionConfig
+	if err := json.Unmarshal(raw, &canonical); err != nil {
+		return "", err
+	}
+
+	if ps := canonical.PodSelector; ps != nil {
+		ps.Kind = lo.EmptyableToPtr(lo.FromPtr(ps.Kind))
+		ps.Namespace = lo.EmptyableToPtr(lo.FromPtr(ps.Namespace))
+		ps.ReplicasMin = lo.EmptyableToPtr(lo.FromPtr(ps.ReplicasMin))
+		if ps.LabelSelector != nil {
+			canonicalizeLabelSelector(ps.LabelSelector)
+			if ps.LabelSelector.MatchExpressions == nil && ps.LabelSelector.MatchLabels == nil {
+				ps.LabelSelector = nil
+			}
+		}
+	}
+	if canonical.NodeSelector != nil {
+		canonicalizeLabelSelector(&canonical.NodeSelector.LabelSelector)
+	}
+	for _, setting := range []**sdk.CastaiEvictorV1EvictionSettingsSettingEnabled{
+		&canonical.Settings.Aggressive,
+		&canonical.Settings.Disposable,
+		&canonical.Settings.RemovalDisabled,
+	} {
+		if *setting != nil && !(*setting).Enabled {
+			*setting = nil
+		}
+	}
+
+	key, err := json.Marshal(canonical)
+	if err != nil {
+		return "", err
+	}
+	return string(key), nil
+}
+
+// canonicalizeLabelSelector drops empty match labels and expressions, and sorts the expressions.
+func canonicalizeLabelSelector(selector *sdk.CastaiEvictorV1LabelSelector) {
+	if len(lo.FromPtr(selector.MatchLabels)) == 0 {
+		selector.MatchLabels = nil
+	}
+	if len(lo.FromPtr(selector.MatchExpressions)) == 0 {
+		selector.MatchExpressions = nil
+	}
+	sortMatchExpressions(selector.MatchExpressions)
+}
+
+func sortMatchExpressions(expressions *[]sdk.CastaiEvictorV1LabelSelectorExpression) {
+	if expressions == nil {
+		return
+	}
+
+	for _, expression := range *expressions {
+		if expression.Values != nil {
+			sort.Strings(*expression.Values)
+		}
+	}
+	sort.Slice(*expressions, func(i, j int) bool {
+		a, b := (*expressions)[i], (*expressions)[j]
+		if a.Key != b.Key {
+			return a.Key < b.Key
+		}
+		if a.Operator != b.Operator {
+			return a.Operator < b.Operator
+		}
+		return fmt.Sprint(lo.FromPtr(a.Values)) < fmt.Sprint(lo.FromPtr(b.Values))
+	})
+}
+
 func resourceEvictionConfigCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if err := upsertEvictionConfigs(ctx, data, meta); err != nil {
 		return diag.FromErr(err)
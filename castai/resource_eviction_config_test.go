@@ -505,3 +505,159 @@ func TestEvictionConfig_DeleteContext(t *testing.T) {
 	r.False(isOK)
 	r.Equal([]interface{}{}, eac)
 }
+
+func TestEvictionConfig_Importer(t *testing.T) {
+	ctx := context.Background()
+	resource := resourceEvictionConfig()
+
+	t.Run("should set cluster id", func(t *testing.T) {
+		r := require.New(t)
+		clusterId := "b6bfc074-a267-400f-b8f1-db0850c369b1"
+		data := resource.Data(&terraform.InstanceState{ID: clusterId})
+
+		result, err := resource.Importer.StateContext(ctx, data, nil)
+		r.NoError(err)
+		r.Len(result, 1)
+		r.Equal(clusterId, result[0].Get(FieldClusterId))
+	})
+
+	t.Run("should reject invalid cluster id", func(t *testing.T) {
+		r := require.New(t)
+		data := resource.Data(&terraform.InstanceState{ID: "not-a-cluster-id"})
+
+		_, err := resource.Importer.StateContext(ctx, data, nil)
+		r.ErrorContains(err, "expected cluster_id to be a valid UUID")
+	})
+}
+
+func TestEvictionConfig_Diff(t *testing.T) {
+	ctx := context.Background()
+	clusterId := "b6bfc074-a267-400f-b8f1-db0850c369b1"
+	resource := resourceEvictionConfig()
+
+	aggressive := map[string]any{
+		FieldPodSelector: []any{map[string]any{
+			FieldPodSelectorNamespace: "batch",
+			FieldMatchExpressions: []any{
+				map[string]any{FieldMatchExpressionKey: "app", FieldMatchExpressionOp: "In", FieldMatchExpressionVal: []any{"a", "b"}},
+				map[string]any{FieldMatchExpressionKey: "tier", FieldMatchExpressionOp: "Exists"},
+			},
+		}},
+		FieldEvictionOptionAggressive: true,
+	}
+	aggressiveReordered := map[string]any{
+		FieldPodSelector: []any{map[string]any{
+			FieldPodSelectorNamespace: "batch",
+			FieldMatchExpressions: []any{
+				map[string]any{FieldMatchExpressionKey: "tier", FieldMatchExpressionOp: "Exists"},
+				map[string]any{FieldMatchExpressionKey: "app", FieldMatchExpressionOp: "In", FieldMatchExpressionVal: []any{"b", "a"}},
+			},
+		}},
+		FieldEvictionOptionAggressive: true,
+	}
+	disposable := map[string]any{
+		FieldNodeSelector: []any{map[string]any{
+			FieldMatchLabels: map[string]any{"pool": "spot"},
+		}},
+		FieldEvictionOptionDisposable: true,
+	}
+	removalDisabled := map[string]any{
+		FieldPodSelector: []any{map[string]any{
+			FieldPodSelectorKind: "Job",
+		}},
+		FieldEvictionOptionDisabled: true,
+	}
+
+	state := func(r *require.Assertions, configs ...map[string]any) *terraform.InstanceState {
+		data := resource.Data(&terraform.InstanceState{ID: clusterId})
+		r.NoError(data.Set(FieldClusterId, clusterId))
+		r.NoError(data.Set(FieldEvictorAdvancedConfig, configs))
+		return data.State()
+	}
+	config := func(configs ...map[string]any) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]any{
+			FieldClusterId:             clusterId,
+			FieldEvictorAdvancedConfig: lo.ToAnySlice(configs),
+		})
+	}
+
+	t.Run("should ignore reordered configs and selectors", func(t *testing.T) {
+		r := require.New(t)
+
+		diff, err := resource.Diff(ctx, state(r, aggressive, disposable), config(disposable, aggressiveReordered), nil)
+		r.NoError(err)
+		r.True(diff == nil || diff.Empty(), "unexpected diff: %v", diff)
+	})
+
+	t.Run("should show added configs", func(t *testing.T) {
+		r := require.New(t)
+
+		diff, err := resource.Diff(ctx, state(r, aggressive, disposable), config(disposable, removalDisabled, aggressive), nil)
+		r.NoError(err)
+		r.NotNil(diff)
+		r.False(diff.Empty())
+	})
+
+	t.Run("should show removed configs", func(t *testing.T) {
+		r := require.New(t)
+
+		diff, err := resource.Diff(ctx, state(r, aggressive, disposable, removalDisabled), config(removalDisabled, aggressive), nil)
+		r.NoError(err)
+		r.NotNil(diff)
+		r.False(diff.Empty())
+	})
+}
+
+func TestEvictionConfigKey(t *testing.T) {
+	r := require.New(t)
+
+	minimal := sdk.CastaiEvictorV1EvictionConfig{
+		PodSelector: &sdk.CastaiEvictorV1PodSelector{
+			Namespace: lo.ToPtr("batch"),
+			LabelSelector: &sdk.CastaiEvictorV1LabelSelector{
+				MatchExpressions: &[]sdk.CastaiEvictorV1LabelSelectorExpression{
+					{Key: "tier", Operator: "Exists"},
+					{Key: "app", Operator: "In", Values: &[]string{"b", "a"}},
+				},
+			},
+		},
+		NodeSelector: &sdk.CastaiEvictorV1NodeSelector{},
+		Settings: sdk.CastaiEvictorV1EvictionSettings{
+			Aggressive: &sdk.CastaiEvictorV1EvictionSettingsSettingEnabled{Enabled: true},
+		},
+	}
+	explicit := sdk.CastaiEvictorV1EvictionConfig{
+		PodSelector: &sdk.CastaiEvictorV1PodSelector{
+			Kind:      lo.ToPtr(""),
+			Namespace: lo.ToPtr("batch"),
+			LabelSelector: &sdk.CastaiEvictorV1LabelSelector{
+				MatchLabels: &map[string]string{},
+				MatchExpressions: &[]sdk.CastaiEvictorV1LabelSelectorExpression{
+					{Key: "app", Operator: "In", Values: &[]string{"a", "b"}},
+					{Key: "tier", Operator: "Exists"},
+				},
+			},
+			ReplicasMin: lo.ToPtr(int32(0)),
+		},
+		NodeSelector: &sdk.CastaiEvictorV1NodeSelector{
+			LabelSelector: sdk.CastaiEvictorV1LabelSelector{MatchLabels: &map[string]string{}},
+		},
+		Settings: sdk.CastaiEvictorV1EvictionSettings{
+			Aggressive:      &sdk.CastaiEvictorV1EvictionSettingsSettingEnabled{Enabled: true},
+			Disposable:      &sdk.CastaiEvictorV1EvictionSettingsSettingEnabled{Enabled: false},
+			RemovalDisabled: &sdk.CastaiEvictorV1EvictionSettingsSettingEnabled{Enabled: false},
+		},
+	}
+
+	minimalKey, err := evictionConfigKey(minimal)
+	r.NoError(err)
+	explicitKey, err := evictionConfigKey(explicit)
+	r.NoError(err)
+	r.Equal(minimalKey, explicitKey)
+	r.Equal("b", (*(*explicit.PodSelector.LabelSelector.MatchExpressions)[0].Values)[1], "config must not be modified")
+
+	explicit.Settings.Disposable.Enabled = true
+	changedKey, err := evictionConfigKey(explicit)
+	r.NoError(err)
+	r.NotEqual(minimalKey, changedKey)
+}
@@ -0,0 +1,332 @@
+package castai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+)
+
+var errEvictionConfigConflict = errors.New("evictor advanced config was changed concurrently")
+
+// evictionConfigLocks serializes read-modify-write cycles of a cluster's evictor advanced config within the provider,
+// as rules of the same cluster are applied in parallel.
+var evictionConfigLocks sync.Map
+
+func resourceEvictorRule() *schema.Resource {
+	ruleSchema := resourceEvictionConfig().Schema[FieldEvictorAdvancedConfig].Elem.(*schema.Resource).Schema
+	podSelector := *ruleSchema[FieldPodSelector]
+	podSelector.MaxItems = 1
+	nodeSelector := *ruleSchema[FieldNodeSelector]
+	nodeSelector.MaxItems = 1
+	settings := []string{FieldEvictionOptionDisabled, FieldEvictionOptionAggressive, FieldEvictionOptionDisposable}
+
+	return &schema.Resource{
+		ReadContext:   resourceEvictorRuleRead,
+		CreateContext: resourceEvictorRuleCreate,
+		UpdateContext: resourceEvictorRuleUpdate,
+		DeleteContext: resourceEvictorRuleDelete,
+		Description: "CAST AI evictor rule resource to manage a single entry of the cluster's evictor advanced config. " +
+			"Entries owned by other resources or created in the console are left untouched. " +
+			"Don't combine it with `castai_evictor_advanced_config` for the same cluster, which replaces all entries.",
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			FieldClusterId: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsUUID),
+				Description:      "CAST AI cluster id.",
+			},
+			FieldPodSelector:  &podSelector,
+			FieldNodeSelector: &nodeSelector,
+			FieldEvictionOptionDisabled: {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				AtLeastOneOf: settings,
+				Description:  "Mark pods as removal disabled",
+			},
+			FieldEvictionOptionAggressive: {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				AtLeastOneOf: settings,
+				Description:  "Apply Aggressive mode to Evictor",
+			},
+			FieldEvictionOptionDisposable: {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				AtLeastOneOf: settings,
+				Description:  "Mark node as disposable",
+			},
+		},
+	}
+}
+
+func resourceEvictorRuleRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clusterID := getClusterId(data)
+	client := meta.(*ProviderConfig).api
+
+	rule, err := toEvictorRule(data.Get)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	configs, err := getEvictionConfigs(ctx, client, clusterID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	found, err := containsEvictionConfig(configs, rule)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found && !data.IsNewResource() {
+		log.Printf("[WARN] Evictor rule (%s) not found in advanced config of cluster %s, removing from state", data.Id(), clusterID)
+		data.SetId("")
+	}
+
+	return nil
+}
+
+func resourceEvictorRuleCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clusterID := getClusterId(data)
+	client := meta.(*ProviderConfig).api
+
+	rule, err := toEvictorRule(data.Get)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id, err := evictorRuleID(clusterID, rule)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = modifyEvictionConfigs(ctx, client, clusterID, func(configs []sdk.CastaiEvictorV1EvictionConfig, firstAttempt bool) ([]sdk.CastaiEvictorV1EvictionConfig, error) {
+		found, err := containsEvictionConfig(configs, rule)
+		if err != nil || !found {
+			return append(configs, rule), err
+		}
+		if firstAttempt {
+			return nil, fmt.Errorf("an identical evictor rule already exists in cluster %s", clusterID)
+		}
+		return configs, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(id)
+	return resourceEvictorRuleRead(ctx, data, meta)
+}
+
+func resourceEvictorRuleUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clusterID := getClusterId(data)
+	client := meta.(*ProviderConfig).api
+
+	oldRule, err := toEvictorRule(func(key string) interface{} {
+		oldValue, _ := data.GetChange(key)
+		return oldValue
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	newRule, err := toEvictorRule(data.Get)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id, err := evictorRuleID(clusterID, newRule)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = modifyEvictionConfigs(ctx, client, clusterID, func(configs []sdk.CastaiEvictorV1EvictionConfig, firstAttempt bool) ([]sdk.CastaiEvictorV1EvictionConfig, error) {
+		configs, err := removeEvictionConfig(configs, oldRule)
+		if err != nil {
+			return nil, err
+		}
+		found, err := containsEvictionConfig(configs, newRule)
+		if err != nil || !found {
+			return append(configs, newRule), err
+		}
+		if firstAttempt {
+			return nil, fmt.Errorf("an identical evictor rule already exists in cluster %s", clusterID)
+		}
+		return configs, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(id)
+	return resourceEvictorRuleRead(ctx, data, meta)
+}
+
+func resourceEvictorRuleDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clusterID := getClusterId(data)
+	client := meta.(*ProviderConfig).api
+
+	rule, err := toEvictorRule(data.Get)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = modifyEvictionConfigs(ctx, client, clusterID, func(configs []sdk.CastaiEvictorV1EvictionConfig, _ bool) ([]sdk.CastaiEvictorV1EvictionConfig, error) {
+		return removeEvictionConfig(configs, rule)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// evictorRuleID identifies the rule by its cluster and canonical form, so it changes whenever the rule is updated.
+func evictorRuleID(clusterID string, rule sdk.CastaiEvictorV1EvictionConfig) (string, error) {
+	key, err := evictionConfigKey(rule)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s/%d", clusterID, schema.HashString(key)), nil
+}
+
+// toEvictorRule builds the eviction config entry of the rule from values returned by get.
+func toEvictorRule(get func(key string) interface{}) (sdk.CastaiEvictorV1EvictionConfig, error) {
+	configs, err := toEvictionConfig([]interface{}{map[string]interface{}{
+		FieldPodSelector:              get(FieldPodSelector),
+		FieldNodeSelector:             get(FieldNodeSelector),
+		FieldEvictionOptionDisabled:   get(FieldEvictionOptionDisabled),
+		FieldEvictionOptionAggressive: get(FieldEvictionOptionAggressive),
+		FieldEvictionOptionDisposable: get(FieldEvictionOptionDisposable),
+	}})
+	if err != nil {
+		return sdk.CastaiEvictorV1EvictionConfig{}, err
+	}
+
+	return configs[0], nil
+}
+
+func getEvictionConfigs(ctx context.Context, client sdk.ClientWithResponsesInterface, clusterID string) ([]sdk.CastaiEvictorV1EvictionConfig, error) {
+	resp, err := client.EvictorAPIGetAdvancedConfigWithResponse(ctx, clusterID)
+	if checkErr := sdk.CheckOKResponse(resp, err); checkErr != nil {
+		return nil, fmt.Errorf("getting evictor advanced config: %w", checkErr)
+	}
+
+	return resp.JSON200.EvictionConfig, nil
+}
+
+func containsEvictionConfig(configs []sdk.CastaiEvictorV1EvictionConfig, config sdk.CastaiEvictorV1EvictionConfig) (bool, error) {
+	remaining, err := removeEvictionConfig(configs, config)
+	if err != nil {
+		return false, err
+	}
+	return len(remaining) != len(configs), nil
+}
+
+// removeEvictionConfig returns configs without entries equal to the given config, keeping the order of other entries.
+func removeEvictionConfig(configs []sdk.CastaiEvictorV1EvictionConfig, config sdk.CastaiEvictorV1EvictionConfig) ([]sdk.CastaiEvictorV1EvictionConfig, error) {
+	key, err := evictionConfigKey(config)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]sdk.CastaiEvictorV1EvictionConfig, 0, len(configs))
+	for _, c := range configs {
+		k, err := evictionConfigKey(c)
+		if err != nil {
+			return nil, err
+		}
+		if k != key {
+			out = append(out, c)
+		}
+	}
+
+	return out, nil
+}
+
+// modifyEvictionConfigs applies modify to the cluster's evictor advanced config and writes the result back.
+// The API has no versioning, so the config is read again afterwards and modify is reapplied until it doesn't change
+// anything, which covers writes made by others in between. Modify must be idempotent after its first attempt.
+func modifyEvictionConfigs(
+	ctx context.Context,
+	client sdk.ClientWithResponsesInterface,
+	clusterID string,
+	modify func(configs []sdk.CastaiEvictorV1EvictionConfig, firstAttempt bool) ([]sdk.CastaiEvictorV1EvictionConfig, error),
+) error {
+	lock, _ := evictionConfigLocks.LoadOrStore(clusterID, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	backoff := wait.Backoff{
+		Duration: 500 * time.Millisecond,
+		Factor:   2.0,
+		Jitter:   0.1,
+		Steps:    6,
+		Cap:      5 * time.Second,
+	}
+
+	firstAttempt := true
+	err := wait.ExponentialBackoffWithContext(ctx, backoff, func(ctx context.Context) (bool, error) {
+		current, err := getEvictionConfigs(ctx, client, clusterID)
+		if err != nil {
+			return false, err
+		}
+		desired, err := modify(current, firstAttempt)
+		if err != nil {
+			return false, err
+		}
+		if !firstAttempt {
+			if unchanged, err := sameEvictionConfigs(current, desired); err != nil || unchanged {
+				return unchanged, err
+			}
+			log.Printf("[DEBUG] %v in cluster %s, retrying", errEvictionConfigConflict, clusterID)
+		}
+		firstAttempt = false
+
+		resp, err := client.EvictorAPIUpsertAdvancedConfigWithResponse(ctx, clusterID, sdk.CastaiEvictorV1AdvancedConfig{
+			EvictionConfig: lo.Ternary(desired == nil, []sdk.CastaiEvictorV1EvictionConfig{}, desired),
+		})
+		if err == nil && resp.StatusCode() == http.StatusConflict {
+			log.Printf("[DEBUG] %v in cluster %s, retrying", errEvictionConfigConflict, clusterID)
+			return false, nil
+		}
+		if checkErr := sdk.CheckOKResponse(resp, err); checkErr != nil {
+			return false, fmt.Errorf("upserting evictor advanced config: %w", checkErr)
+		}
+		return false, nil
+	})
+	if wait.Interrupted(err) {
+		return fmt.Errorf("updating evictor advanced config of cluster %s: %w", clusterID, errEvictionConfigConflict)
+	}
+
+	return err
+}
+
+func sameEvictionConfigs(a, b []sdk.CastaiEvictorV1EvictionConfig) (bool, error) {
+	aKeys, err := sortedEvictionConfigKeys(a)
+	if err != nil {
+		return false, err
+	}
+	bKeys, err := sortedEvictionConfigKeys(b)
+	if err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(aKeys, bKeys), nil
+}
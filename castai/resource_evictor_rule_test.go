@@ -0,0 +1,330 @@
+package castai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+	mock_sdk "github.com/castai/terraform-provider-castai/castai/sdk/mock"
+)
+
+const (
+	evictorRuleOtherConfig = `{"podSelector":{"kind":"Job"},"settings":{"removalDisabled":{"enabled":true}}}`
+	evictorRuleConfig      = `{"podSelector":{"namespace":"batch","labelSelector":{"matchLabels":{"app":"worker"}}},"settings":{"aggressive":{"enabled":true}}}`
+)
+
+func TestEvictorRule_CreateContext(t *testing.T) {
+	t.Parallel()
+
+	clusterID := "b6bfc074-a267-400f-b8f1-db0850c369b1"
+
+	t.Run("should append rule and keep other entries", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient, provider := newEvictorRuleMocks(t)
+
+		var upserted sdk.CastaiEvictorV1AdvancedConfig
+		gomock.InOrder(
+			mockClient.EXPECT().EvictorAPIGetAdvancedConfig(gomock.Any(), clusterID).
+				Return(evictorAdvancedConfigResponse(evictorRuleOtherConfig), nil),
+			mockClient.EXPECT().EvictorAPIUpsertAdvancedConfig(gomock.Any(), clusterID, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, body sdk.CastaiEvictorV1AdvancedConfig, _ ...sdk.RequestEditorFn) (*http.Response, error) {
+					upserted = body
+					return evictorAdvancedConfigResponse(evictorRuleOtherConfig, evictorRuleConfig), nil
+				}),
+			mockClient.EXPECT().EvictorAPIGetAdvancedConfig(gomock.Any(), clusterID).
+				Return(evictorAdvancedConfigResponse(evictorRuleOtherConfig, evictorRuleConfig), nil),
+			mockClient.EXPECT().EvictorAPIGetAdvancedConfig(gomock.Any(), clusterID).
+				Return(evictorAdvancedConfigResponse(evictorRuleOtherConfig, evictorRuleConfig), nil),
+		)
+
+		resource := resourceEvictorRule()
+		data := schema.TestResourceDataRaw(t, resource.Schema, evictorRuleRaw(clusterID))
+
+		result := resource.CreateContext(context.Background(), data, provider)
+		r.Nil(result)
+		r.Contains(data.Id(), clusterID+"/")
+		r.Len(upserted.EvictionConfig, 2)
+		r.Equal("Job", *upserted.EvictionConfig[0].PodSelector.Kind)
+		r.Equal("batch", *upserted.EvictionConfig[1].PodSelector.Namespace)
+		r.True(upserted.EvictionConfig[1].Settings.Aggressive.Enabled)
+	})
+
+	t.Run("should retry when rule was overwritten concurrently", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient, provider := newEvictorRuleMocks(t)
+
+		gomock.InOrder(
+			mockClient.EXPECT().EvictorAPIGetAdvancedConfig(gomock.Any(), clusterID).
+				Return(evictorAdvancedConfigResponse(), nil),
+			mockClient.EXPECT().EvictorAPIUpsertAdvancedConfig(gomock.Any(), clusterID, gomock.Any()).
+				Return(evictorAdvancedConfigResponse(evictorRuleConfig), nil),
+			// Someone else replaced the whole config in between.
+			mockClient.EXPECT().EvictorAPIGetAdvancedConfig(gomock.Any(), clusterID).
+				Return(evictorAdvancedConfigResponse(evictorRuleOtherConfig), nil),
+			mockClient.EXPECT().EvictorAPIUpsertAdvancedConfig(gomock.Any(), clusterID, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, body sdk.CastaiEvictorV1AdvancedConfig, _ ...sdk.RequestEditorFn) (*http.Response, error) {
+					r.Len(body.EvictionConfig, 2)
+					return evictorAdvancedConfigResponse(evictorRuleOtherConfig, evictorRuleConfig), nil
+				}),
+			mockClient.EXPECT().EvictorAPIGetAdvancedConfig(gomock.Any(), clusterID).
+				Return(evictorAdvancedConfigResponse(evictorRuleConfig, evictorRuleOtherConfig), nil),
+			mockClient.EXPECT().EvictorAPIGetAdvancedConfig(gomock.Any(), clusterID).
+				Return(evictorAdvancedConfigResponse(evictorRuleConfig, evictorRuleOtherConfig), nil),
+		)
+
+		resource := resourceEvictorRule()
+		data := schema.TestResourceDataRaw(t, resource.Schema, evictorRuleRaw(clusterID))
+
+		result := resource.CreateContext(context.Background(), data, provider)
+		r.Nil(result)
+	})
+
+	t.Run("should retry when upsert conflicts", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient, provider := newEvictorRuleMocks(t)
+
+		conflict := evictorAdvancedConfigResponse()
+		conflict.StatusCode = http.StatusConflict
+		gomock.InOrder(
+			mockClient.EXPECT().EvictorAPIGetAdvancedConfig(gomock.Any(), clusterID).
+				Return(evictorAdvancedConfigResponse(evictorRuleOtherConfig), nil),
+			mockClient.EXPECT().EvictorAPIUpsertAdvancedConfig(gomock.Any(), clusterID, gomock.Any()).
+				Return(conflict, nil),
+			mockClient.EXPECT().EvictorAPIGetAdvancedConfig(gomock.Any(), clusterID).
+				Return(evictorAdvancedConfigResponse(evictorRuleOtherConfig), nil),
+			mockClient.EXPECT().EvictorAPIUpsertAdvancedConfig(gomock.Any(), clusterID, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, body sdk.CastaiEvictorV1AdvancedConfig, _ ...sdk.RequestEditorFn) (*http.Response, error) {
+					r.Len(body.EvictionConfig, 2)
+					return evictorAdvancedConfigResponse(evictorRuleOtherConfig, evictorRuleConfig), nil
+				}),
+			mockClient.EXPECT().EvictorAPIGetAdvancedConfig(gomock.Any(), clusterID).
+				Return(evictorAdvancedConfigResponse(evictorRuleOtherConfig, evictorRuleConfig), nil),
+			mockClient.EXPECT().EvictorAPIGetAdvancedConfig(gomock.Any(), clusterID).
+				Return(evictorAdvancedConfigResponse(evictorRuleOtherConfig, evictorRuleConfig), nil),
+		)
+
+		resource := resourceEvictorRule()
+		data := schema.TestResourceDataRaw(t, resource.Schema, evictorRuleRaw(clusterID))
+
+		result := resource.CreateContext(context.Background(), data, provider)
+		r.Nil(result)
+		r.Contains(data.Id(), clusterID+"/")
+	})
+
+	t.Run("should fail when identical rule exists", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient, provider := newEvictorRuleMocks(t)
+
+		mockClient.EXPECT().EvictorAPIGetAdvancedConfig(gomock.Any(), clusterID).
+			Return(evictorAdvancedConfigResponse(evictorRuleConfig), nil)
+
+		resource := resourceEvictorRule()
+		data := schema.TestResourceDataRaw(t, resource.Schema, evictorRuleRaw(clusterID))
+
+		result := resource.CreateContext(context.Background(), data, provider)
+		r.True(result.HasError())
+		r.Contains(result[0].Summary, "an identical evictor rule already exists")
+	})
+}
+
+func TestEvictorRule_ReadContext(t *testing.T) {
+	t.Parallel()
+
+	clusterID := "b6bfc074-a267-400f-b8f1-db0850c369b2"
+
+	tests := map[string]struct {
+		configs    []string
+		expectedID string
+	}{
+		"should keep rule found in any position": {
+			configs:    []string{evictorRuleOtherConfig, evictorRuleConfig},
+			expectedID: clusterID + "/1",
+		},
+		"should remove rule deleted in console from state": {
+			configs:    []string{evictorRuleOtherConfig},
+			expectedID: "",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			r := require.New(t)
+			mockClient, provider := newEvictorRuleMocks(t)
+
+			mockClient.EXPECT().EvictorAPIGetAdvancedConfig(gomock.Any(), clusterID).
+				Return(evictorAdvancedConfigResponse(test.configs...), nil)
+
+			resource := resourceEvictorRule()
+			state := terraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{
+				FieldClusterId: cty.StringVal(clusterID),
+				FieldPodSelector: cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+					FieldPodSelectorNamespace: cty.StringVal("batch"),
+					FieldMatchLabels:          cty.MapVal(map[string]cty.Value{"app": cty.StringVal("worker")}),
+				})}),
+				FieldEvictionOptionAggressive: cty.BoolVal(true),
+			}), 0)
+			state.ID = clusterID + "/1"
+			data := resource.Data(state)
+
+			result := resource.ReadContext(context.Background(), data, provider)
+			r.Nil(result)
+			r.Equal(test.expectedID, data.Id())
+		})
+	}
+}
+
+func TestEvictorRule_UpdateContext(t *testing.T) {
+	t.Parallel()
+
+	clusterID := "b6bfc074-a267-400f-b8f1-db0850c369b4"
+	updatedConfig := `{"podSelector":{"namespace":"batch","labelSelector":{"matchLabels":{"app":"worker"}}},"settings":{"removalDisabled":{"enabled":true}}}`
+
+	updatedData := func(t *testing.T, resource *schema.Resource) (*schema.ResourceData, string) {
+		r := require.New(t)
+		state := terraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{
+			FieldClusterId: cty.StringVal(clusterID),
+			FieldPodSelector: cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				FieldPodSelectorNamespace: cty.StringVal("batch"),
+				FieldMatchLabels:          cty.MapVal(map[string]cty.Value{"app": cty.StringVal("worker")}),
+			})}),
+			FieldEvictionOptionAggressive: cty.BoolVal(true),
+		}), 0)
+		oldRule, err := toEvictorRule(resource.Data(state).Get)
+		r.NoError(err)
+		oldID, err := evictorRuleID(clusterID, oldRule)
+		r.NoError(err)
+		state.ID = oldID
+
+		data := resource.Data(state)
+		r.NoError(data.Set(FieldEvictionOptionAggressive, false))
+		r.NoError(data.Set(FieldEvictionOptionDisabled, true))
+		return data, oldID
+	}
+
+	t.Run("should replace the old rule", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient, provider := newEvictorRuleMocks(t)
+
+		gomock.InOrder(
+			mockClient.EXPECT().EvictorAPIGetAdvancedConfig(gomock.Any(), clusterID).
+				Return(evictorAdvancedConfigResponse(evictorRuleOtherConfig, evictorRuleConfig), nil),
+			mockClient.EXPECT().EvictorAPIUpsertAdvancedConfig(gomock.Any(), clusterID, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, body sdk.CastaiEvictorV1AdvancedConfig, _ ...sdk.RequestEditorFn) (*http.Response, error) {
+					r.Len(body.EvictionConfig, 2)
+					r.Equal("Job", *body.EvictionConfig[0].PodSelector.Kind)
+					r.True(body.EvictionConfig[1].Settings.RemovalDisabled.Enabled)
+					r.Nil(body.EvictionConfig[1].Settings.Aggressive)
+					return evictorAdvancedConfigResponse(evictorRuleOtherConfig, updatedConfig), nil
+				}),
+			mockClient.EXPECT().EvictorAPIGetAdvancedConfig(gomock.Any(), clusterID).
+				Return(evictorAdvancedConfigResponse(evictorRuleOtherConfig, updatedConfig), nil),
+			mockClient.EXPECT().EvictorAPIGetAdvancedConfig(gomock.Any(), clusterID).
+				Return(evictorAdvancedConfigResponse(evictorRuleOtherConfig, updatedConfig), nil),
+		)
+
+		resource := resourceEvictorRule()
+		data, oldID := updatedData(t, resource)
+
+		result := resource.UpdateContext(context.Background(), data, provider)
+		r.Nil(result)
+
+		newRule, err := toEvictorRule(data.Get)
+		r.NoError(err)
+		newID, err := evictorRuleID(clusterID, newRule)
+		r.NoError(err)
+		r.Equal(newID, data.Id())
+		r.NotEqual(oldID, data.Id())
+	})
+
+	t.Run("should fail when identical rule exists", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient, provider := newEvictorRuleMocks(t)
+
+		mockClient.EXPECT().EvictorAPIGetAdvancedConfig(gomock.Any(), clusterID).
+			Return(evictorAdvancedConfigResponse(evictorRuleConfig, updatedConfig), nil)
+
+		resource := resourceEvictorRule()
+		data, oldID := updatedData(t, resource)
+
+		result := resource.UpdateContext(context.Background(), data, provider)
+		r.True(result.HasError())
+		r.Contains(result[0].Summary, "an identical evictor rule already exists")
+		r.Equal(oldID, data.Id())
+	})
+}
+
+func TestEvictorRule_DeleteContext(t *testing.T) {
+	t.Parallel()
+	r := require.New(t)
+	mockClient, provider := newEvictorRuleMocks(t)
+	clusterID := "b6bfc074-a267-400f-b8f1-db0850c369b3"
+
+	gomock.InOrder(
+		mockClient.EXPECT().EvictorAPIGetAdvancedConfig(gomock.Any(), clusterID).
+			Return(evictorAdvancedConfigResponse(evictorRuleConfig, evictorRuleOtherConfig), nil),
+		mockClient.EXPECT().EvictorAPIUpsertAdvancedConfig(gomock.Any(), clusterID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, body sdk.CastaiEvictorV1AdvancedConfig, _ ...sdk.RequestEditorFn) (*http.Response, error) {
+				r.Len(body.EvictionConfig, 1)
+				r.Equal("Job", *body.EvictionConfig[0].PodSelector.Kind)
+				return evictorAdvancedConfigResponse(evictorRuleOtherConfig), nil
+			}),
+		mockClient.EXPECT().EvictorAPIGetAdvancedConfig(gomock.Any(), clusterID).
+			Return(evictorAdvancedConfigResponse(evictorRuleOtherConfig), nil),
+	)
+
+	resource := resourceEvictorRule()
+	data := schema.TestResourceDataRaw(t, resource.Schema, evictorRuleRaw(clusterID))
+	data.SetId(clusterID + "/1")
+
+	result := resource.DeleteContext(context.Background(), data, provider)
+	r.Nil(result)
+}
+
+func newEvictorRuleMocks(t *testing.T) (*mock_sdk.MockClientInterface, *ProviderConfig) {
+	mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+	return mockClient, &ProviderConfig{
+		api: &sdk.ClientWithResponses{
+			ClientInterface: mockClient,
+		},
+	}
+}
+
+func evictorRuleRaw(clusterID string) map[string]any {
+	return map[string]any{
+		FieldClusterId: clusterID,
+		FieldPodSelector: []any{map[string]any{
+			FieldPodSelectorNamespace: "batch",
+			FieldMatchLabels:          map[string]any{"app": "worker"},
+		}},
+		FieldEvictionOptionAggressive: true,
+	}
+}
+
+func evictorAdvancedConfigResponse(configs ...string) *http.Response {
+	body, _ := json.Marshal(map[string]any{
+		"evictionConfig": lo.Map(configs, func(config string, _ int) json.RawMessage { return json.RawMessage(config) }),
+	})
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewReader(body)),
+		Header:     map[string][]string{"Content-Type": {"json"}},
+	}
+}
@@ -27,7 +27,10 @@ func resourceGKECluster() *schema.Resource {
 		ReadContext:   resourceCastaiGKEClusterRead,
 		UpdateContext: resourceCastaiGKEClusterUpdate,
 		DeleteContext: resourceCastaiGKEClusterDelete,
-		Description:   "GKE cluster resource allows connecting an existing GKE cluster to CAST AI.",
+		Importer: &schema.ResourceImporter{
+			StateContext: clusterStateImporter(importGKEClusterFields),
+		},
+		Description: "GKE cluster resource allows connecting an existing GKE cluster to CAST AI.",
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
@@ -215,6 +218,24 @@ func updateGKEClusterSettings(ctx context.Context, data *schema.ResourceData, cl
 	return resourceCastaiClusterUpdate(ctx, client, data, &req)
 }
 
+func importGKEClusterFields(cluster *sdk.ExternalclusterV1Cluster, data *schema.ResourceData) error {
+	if cluster.Gke == nil {
+		return fmt.Errorf("cluster %s is not a GKE cluster", data.Id())
+	}
+
+	if err := data.Set(FieldGKEClusterName, toString(cluster.Gke.ClusterName)); err != nil {
+		return fmt.Errorf("setting cluster name: %w", err)
+	}
+	if err := data.Set(FieldGKEClusterProjectId, toString(cluster.Gke.ProjectId)); err != nil {
+		return fmt.Errorf("setting project id: %w", err)
+	}
+	if err := data.Set(FieldGKEClusterLocation, toString(cluster.Gke.Location)); err != nil {
+		return fmt.Errorf("setting location: %w", err)
+	}
+
+	return nil
+}
+
 func resourceCastaiGKEClusterDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Disable service account used for impersonation.
 	client := meta.(*ProviderConfig).api
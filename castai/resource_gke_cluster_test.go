@@ -359,3 +359,56 @@ func TestGKEClusterResourceUpdate(t *testing.T) {
 		})
 	})
 }
+
+func TestGKEClusterResourceImport(t *testing.T) {
+	r := require.New(t)
+	mockctrl := gomock.NewController(t)
+	mockClient := mock_sdk.NewMockClientInterface(mockctrl)
+
+	ctx := context.Background()
+	provider := &ProviderConfig{
+		api: &sdk.ClientWithResponses{
+			ClientInterface: mockClient,
+		},
+	}
+
+	clusterId := "b6bfc074-a267-400f-b8f1-db0850c369b1"
+	clusterResponse := `{
+  "id": "b6bfc074-a267-400f-b8f1-db0850c369b1",
+  "name": "gke-cluster",
+  "organizationId": "2836f775-aaaa-eeee-bbbb-3d3c29512692",
+  "credentialsId": "9b8d0456-177b-4a3d-b162-e68030d656aa",
+  "status": "ready",
+  "agentStatus": "online",
+  "providerType": "gke",
+  "gke": {
+    "clusterName": "gke-cluster",
+    "region": "eu-central1",
+    "location": "eu-central1-a",
+    "projectId": "project-id"
+  }
+}`
+	mockClient.EXPECT().
+		ExternalClusterAPIGetCluster(gomock.Any(), clusterId).
+		DoAndReturn(func(_ context.Context, _ string, _ ...sdk.RequestEditorFn) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(clusterResponse))), Header: map[string][]string{"Content-Type": {"json"}}}, nil
+		}).Times(2)
+
+	resource := resourceGKECluster()
+	state := terraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{}), 0)
+	state.ID = clusterId
+	data := resource.Data(state)
+
+	imported, err := resource.Importer.StateContext(ctx, data, provider)
+	r.NoError(err)
+	r.Len(imported, 1)
+
+	result := resource.ReadContext(ctx, imported[0], provider)
+	r.Nil(result)
+	r.Equal("gke-cluster", imported[0].Get(FieldGKEClusterName))
+	r.Equal("project-id", imported[0].Get(FieldGKEClusterProjectId))
+	r.Equal("eu-central1-a", imported[0].Get(FieldGKEClusterLocation))
+	r.Equal("9b8d0456-177b-4a3d-b162-e68030d656aa", imported[0].Get(FieldClusterCredentialsId))
+	// Credentials are write-only and must not be replaced with the drift marker.
+	r.Empty(imported[0].Get(FieldGKEClusterCredentials))
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -91,6 +92,187 @@ var scheduleSchema = &schema.Schema{
 	},
 }
 
+// hibernationNodeConfigResource describes the node created when a hibernated cluster is resumed.
+var hibernationNodeConfigResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		FieldHibernationScheduleInstanceType: {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			Description:      "Instance type.",
+		},
+		FieldHibernationScheduleConfigId: {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			Description:      "ID reference of Node Configuration to be used for node creation. Supersedes 'config_name' parameter.",
+		},
+		FieldHibernationScheduleConfigName: {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			Description:      "Name reference of Node Configuration to be used for node creation. Superseded if 'config_id' parameter is provided.",
+		},
+		FieldHibernationScheduleGpuConfig: {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					FieldHibernationScheduleCount: {
+						Type:        schema.TypeInt,
+						Required:    true,
+						Description: "Number of GPUs.",
+					},
+					FieldHibernationScheduleType: {
+						Type:             schema.TypeString,
+						Optional:         true,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+						Description:      "GPU type.",
+					},
+				},
+			},
+		},
+		FieldHibernationScheduleKubernetesLabels: {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "Custom labels to be added to the node.",
+		},
+		FieldHibernationScheduleKubernetesTaints: {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					FieldHibernationScheduleKey: {
+						Required:         true,
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+						Description:      "Key of a taint to be added to nodes created from this template.",
+					},
+					FieldHibernationScheduleValue: {
+						Optional:    true,
+						Type:        schema.TypeString,
+						Description: "Value of a taint to be added to nodes created from this template.",
+					},
+					FieldHibernationScheduleEffect: {
+						Optional: true,
+						Type:     schema.TypeString,
+						Default:  TaintEffectNoSchedule,
+						ValidateDiagFunc: validation.ToDiagFunc(
+							validation.StringInSlice([]string{TaintEffectNoSchedule, TaintEffectNoExecute}, false),
+						),
+						Description: fmt.Sprintf("Effect of a taint to be added to nodes created from this template, the default is %s. Allowed values: %s.", TaintEffectNoSchedule, strings.Join([]string{TaintEffectNoSchedule, TaintEffectNoExecute}, ", ")),
+					},
+				},
+			},
+			Description: "Custom taints to be added to the node created from this configuration.",
+		},
+		FieldHibernationScheduleNodeAffinity: {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					FieldHibernationScheduleDedicatedGroup: {
+						Required:         true,
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+						Description:      "Key of a taint to be added to nodes created from this template.",
+					},
+					FieldHibernationScheduleAffinity: {
+						Optional: true,
+						Type:     schema.TypeList,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								FieldHibernationScheduleKey: {
+									Required:    true,
+									Type:        schema.TypeString,
+									Description: "Key of the node affinity selector.",
+								},
+								FieldHibernationScheduleOperator: {
+									Required:         true,
+									Type:             schema.TypeString,
+									ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(supportedAffinityOperators, false)),
+									Description:      fmt.Sprintf("Operator of the node affinity selector. Allowed values: %s.", strings.Join(supportedAffinityOperators, ", ")),
+								},
+								FieldHibernationScheduleValues: {
+									Required: true,
+									Type:     schema.TypeList,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+									Description: "Values of the node affinity selector.",
+								},
+							},
+						},
+					},
+				},
+			},
+			Description: "Custom taints to be added to the node created from this configuration.",
+		},
+		FieldHibernationScheduleSpotConfig: {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					FieldHibernationSchedulePriceHourly: {
+						Optional:         true,
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+						Description:      "Spot instance price. Applicable only for AWS nodes.",
+					},
+					FieldHibernationScheduleSpot: {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Description: "Whether node should be created as spot instance.",
+					},
+				},
+			},
+			Description: "Custom taints to be added to the node created from this configuration.",
+		},
+		FieldHibernationScheduleSubnetId: {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			Description:      "Node subnet ID.",
+		},
+		FieldHibernationScheduleVolume: {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					FieldHibernationScheduleRaidConfig: {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								FieldHibernationScheduleChunkSizeKb: {
+									Type:        schema.TypeInt,
+									Optional:    true,
+									Description: "Specify the RAID0 chunk size in kilobytes, this parameter affects the read/write in the disk array and must be tailored for the type of data written by the workloads in the node. If not provided it will default to 64KB",
+								},
+							},
+						},
+					},
+					FieldHibernationScheduleSizeGib: {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "Volume size in GiB.",
+					},
+				},
+			},
+		},
+		FieldHibernationScheduleZone: {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			Description:      "Zone of the node.",
+		},
+	},
+}
+
 func resourceHibernationSchedule() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceHibernationScheduleCreate,
@@ -100,7 +282,8 @@ func resourceHibernationSchedule() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: hibernationScheduleStateImporter,
 		},
-		Description: "CAST AI hibernation schedule resource to manage hibernation schedules.",
+		Description:   "CAST AI hibernation schedule resource to manage hibernation schedules.",
+		CustomizeDiff: validateHibernationScheduleInventory,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Minute),
@@ -127,6 +310,11 @@ func resourceHibernationSchedule() *schema.Resource {
 				Required:    true,
 				Description: "Enables or disables the schedule.",
 			},
+			FieldValidateInventory: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Warn on apply when the zone of the resume node is outside the region of an assigned cluster or its instance type isn't offered by the cluster's cloud provider, according to CAST AI inventory.",
+			},
 			FieldHibernationSchedulePauseConfig: {
 				Type:     schema.TypeList,
 				Required: true,
@@ -164,185 +352,7 @@ func resourceHibernationSchedule() *schema.Resource {
 										Type:     schema.TypeList,
 										Required: true,
 										MaxItems: 1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												FieldHibernationScheduleInstanceType: {
-													Type:             schema.TypeString,
-													Required:         true,
-													ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
-													Description:      "Instance type.",
-												},
-												FieldHibernationScheduleConfigId: {
-													Type:             schema.TypeString,
-													Optional:         true,
-													ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
-													Description:      "ID reference of Node Configuration to be used for node creation. Supersedes 'config_name' parameter.",
-												},
-												FieldHibernationScheduleConfigName: {
-													Type:             schema.TypeString,
-													Optional:         true,
-													ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
-													Description:      "Name reference of Node Configuration to be used for node creation. Superseded if 'config_id' parameter is provided.",
-												},
-												FieldHibernationScheduleGpuConfig: {
-													Type:     schema.TypeList,
-													Optional: true,
-													MaxItems: 1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															FieldHibernationScheduleCount: {
-																Type:        schema.TypeInt,
-																Required:    true,
-																Description: "Number of GPUs.",
-															},
-															FieldHibernationScheduleType: {
-																Type:             schema.TypeString,
-																Optional:         true,
-																ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
-																Description:      "GPU type.",
-															},
-														},
-													},
-												},
-												FieldHibernationScheduleKubernetesLabels: {
-													Type:     schema.TypeMap,
-													Optional: true,
-													Elem: &schema.Schema{
-														Type: schema.TypeString,
-													},
-													Description: "Custom labels to be added to the node.",
-												},
-												FieldHibernationScheduleKubernetesTaints: {
-													Type:     schema.TypeList,
-													Optional: true,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															FieldHibernationScheduleKey: {
-																Required:         true,
-																Type:             schema.TypeString,
-																ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
-																Description:      "Key of a taint to be added to nodes created from this template.",
-															},
-															FieldHibernationScheduleValue: {
-																Optional:    true,
-																Type:        schema.TypeString,
-																Description: "Value of a taint to be added to nodes created from this template.",
-															},
-															FieldHibernationScheduleEffect: {
-																Optional: true,
-																Type:     schema.TypeString,
-																Default:  TaintEffectNoSchedule,
-																ValidateDiagFunc: validation.ToDiagFunc(
-																	validation.StringInSlice([]string{TaintEffectNoSchedule, TaintEffectNoExecute}, false),
-																),
-																Description: fmt.Sprintf("Effect of a taint to be added to nodes created from this template, the default is %s. Allowed values: %s.", TaintEffectNoSchedule, strings.Join([]string{TaintEffectNoSchedule, TaintEffectNoExecute}, ", ")),
-															},
-														},
-													},
-													Description: "Custom taints to be added to the node created from this configuration.",
-												},
-												FieldHibernationScheduleNodeAffinity: {
-													Type:     schema.TypeList,
-													Optional: true,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															FieldHibernationScheduleDedicatedGroup: {
-																Required:         true,
-																Type:             schema.TypeString,
-																ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
-																Description:      "Key of a taint to be added to nodes created from this template.",
-															},
-															FieldHibernationScheduleAffinity: {
-																Optional: true,
-																Type:     schema.TypeList,
-																Elem: &schema.Resource{
-																	Schema: map[string]*schema.Schema{
-																		FieldHibernationScheduleKey: {
-																			Required:    true,
-																			Type:        schema.TypeString,
-																			Description: "Key of the node affinity selector.",
-																		},
-																		FieldHibernationScheduleOperator: {
-																			Required:         true,
-																			Type:             schema.TypeString,
-																			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(supportedAffinityOperators, false)),
-																			Description:      fmt.Sprintf("Operator of the node affinity selector. Allowed values: %s.", strings.Join(supportedAffinityOperators, ", ")),
-																		},
-																		FieldHibernationScheduleValues: {
-																			Required: true,
-																			Type:     schema.TypeList,
-																			Elem: &schema.Schema{
-																				Type: schema.TypeString,
-																			},
-																			Description: "Values of the node affinity selector.",
-																		},
-																	},
-																},
-															},
-														},
-													},
-													Description: "Custom taints to be added to the node created from this configuration.",
-												},
-												FieldHibernationScheduleSpotConfig: {
-													Type:     schema.TypeList,
-													Optional: true,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															FieldHibernationSchedulePriceHourly: {
-																Optional:         true,
-																Type:             schema.TypeString,
-																ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
-																Description:      "Spot instance price. Applicable only for AWS nodes.",
-															},
-															FieldHibernationScheduleSpot: {
-																Type:        schema.TypeBool,
-																Optional:    true,
-																Description: "Whether node should be created as spot instance.",
-															},
-														},
-													},
-													Description: "Custom taints to be added to the node created from this configuration.",
-												},
-												FieldHibernationScheduleSubnetId: {
-													Type:             schema.TypeString,
-													Optional:         true,
-													ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
-													Description:      "Node subnet ID.",
-												},
-												FieldHibernationScheduleVolume: {
-													Type:     schema.TypeList,
-													Optional: true,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															FieldHibernationScheduleRaidConfig: {
-																Type:     schema.TypeList,
-																Optional: true,
-																Elem: &schema.Resource{
-																	Schema: map[string]*schema.Schema{
-																		FieldHibernationScheduleChunkSizeKb: {
-																			Type:        schema.TypeInt,
-																			Optional:    true,
-																			Description: "Specify the RAID0 chunk size in kilobytes, this parameter affects the read/write in the disk array and must be tailored for the type of data written by the workloads in the node. If not provided it will default to 64KB",
-																		},
-																	},
-																},
-															},
-															FieldHibernationScheduleSizeGib: {
-																Type:        schema.TypeInt,
-																Optional:    true,
-																Description: "Volume size in GiB.",
-															},
-														},
-													},
-												},
-												FieldHibernationScheduleZone: {
-													Type:             schema.TypeString,
-													Optional:         true,
-													ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
-													Description:      "Zone of the node.",
-												},
-											},
-										},
+										Elem:     hibernationNodeConfigResource,
 									},
 								},
 							},
@@ -430,10 +440,10 @@ func resourceHibernationScheduleUpdate(ctx context.Context, d *schema.ResourceDa
 
 	resp, err := client.HibernationSchedulesAPIUpdateHibernationScheduleWithResponse(ctx, organizationID, d.Id(), req)
 	if checkErr := sdk.CheckOKResponse(resp, err); checkErr != nil {
-		return diag.FromErr(fmt.Errorf("could not update hibernation schedule in organization %s: %v", organizationID, checkErr))
+		return apiErrorDiagnostics(fmt.Errorf("could not update hibernation schedule in organization %s: %w", organizationID, checkErr), resourceHibernationSchedule().Schema)
 	}
 
-	return readHibernationScheduleIntoState(ctx, d, meta, organizationID, d.Id())
+	return append(hibernationScheduleApplyWarnings(ctx, d, meta), readHibernationScheduleIntoState(ctx, d, meta, organizationID, d.Id())...)
 }
 
 func resourceHibernationScheduleDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -466,12 +476,12 @@ func resourceHibernationScheduleCreate(ctx context.Context, d *schema.ResourceDa
 
 	resp, err := client.HibernationSchedulesAPICreateHibernationScheduleWithResponse(ctx, organizationID, *schedule)
 	if checkErr := sdk.CheckOKResponse(resp, err); checkErr != nil {
-		return diag.FromErr(fmt.Errorf("could not create hibernation schedule in organization %s: %v", organizationID, checkErr))
+		return apiErrorDiagnostics(fmt.Errorf("could not create hibernation schedule in organization %s: %w", organizationID, checkErr), resourceHibernationSchedule().Schema)
 	}
 
 	d.SetId(*resp.JSON200.Id)
 
-	return readHibernationScheduleIntoState(ctx, d, meta, organizationID, d.Id())
+	return append(hibernationScheduleApplyWarnings(ctx, d, meta), readHibernationScheduleIntoState(ctx, d, meta, organizationID, d.Id())...)
 }
 
 func resourceHibernationScheduleRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -971,3 +981,88 @@ func parseImportID(d *schema.ResourceData) (string, string) {
 
 	return "", id
 }
+
+// validateHibernationScheduleInventory logs warnings about the resume node referencing a zone or instance type which
+// isn't available to one of the assigned clusters. They are reported on apply by hibernationScheduleApplyWarnings. It
+// only runs when the referenced values change, as it calls the API.
+func validateHibernationScheduleInventory(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.Get(FieldValidateInventory).(bool) {
+		return nil
+	}
+	if !d.NewValueKnown(FieldHibernationScheduleResumeConfig) || !d.NewValueKnown(FieldHibernationScheduleClusterAssignments) {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChanges(hibernationScheduleInventoryFields...) {
+		return nil
+	}
+
+	logInventoryWarnings(ctx, hibernationScheduleInventoryDiagnostics(ctx, meta.(*ProviderConfig).api, d.Get))
+	return nil
+}
+
+// hibernationScheduleApplyWarnings reports warnings of the optional inventory validation on apply, as warnings of plan
+// time validation are only logged. Same as during plan, it only runs when the referenced values change.
+func hibernationScheduleApplyWarnings(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if !d.Get(FieldValidateInventory).(bool) || !d.HasChanges(hibernationScheduleInventoryFields...) {
+		return nil
+	}
+	return hibernationScheduleInventoryDiagnostics(ctx, meta.(*ProviderConfig).api, d.Get)
+}
+
+// hibernationScheduleInventoryFields are the attributes which trigger the inventory validation when changed.
+var hibernationScheduleInventoryFields = []string{
+	FieldHibernationScheduleResumeConfig,
+	FieldHibernationScheduleClusterAssignments,
+	FieldValidateInventory,
+}
+
+// hibernationScheduleInventoryDiagnostics checks the resume node of the schedule built from values returned by get
+// against CAST AI inventory of every assigned cluster.
+func hibernationScheduleInventoryDiagnostics(ctx context.Context, client sdk.ClientWithResponsesInterface, get func(key string) any) diag.Diagnostics {
+	zones, instanceTypes := hibernationScheduleInventoryReferences(get(hibernationScheduleResumeNodeConfigPath))
+	clusterIDs := hibernationScheduleClusterIDs(get(FieldHibernationScheduleClusterAssignments))
+	return inventoryDiagnostics(ctx, client, clusterIDs, zones, instanceTypes)
+}
+
+// hibernationScheduleResumeNodeConfigPath points to the configuration of the node created on resume.
+var hibernationScheduleResumeNodeConfigPath = strings.Join([]string{
+	FieldHibernationScheduleResumeConfig, "0", FieldHibernationScheduleJobConfig, "0", FieldHibernationScheduleNodeConfig,
+}, ".")
+
+func hibernationScheduleInventoryReferences(nodeConfig any) (zones, instanceTypes []inventoryReference) {
+	list, ok := nodeConfig.([]any)
+	if !ok || len(list) == 0 || list[0] == nil {
+		return nil, nil
+	}
+	obj := list[0].(map[string]any)
+	path := cty.GetAttrPath(FieldHibernationScheduleResumeConfig).IndexInt(0).
+		GetAttr(FieldHibernationScheduleJobConfig).IndexInt(0).
+		GetAttr(FieldHibernationScheduleNodeConfig).IndexInt(0)
+
+	if v, ok := obj[FieldHibernationScheduleZone].(string); ok && v != "" {
+		zones = append(zones, inventoryReference{value: v, path: path.GetAttr(FieldHibernationScheduleZone)})
+	}
+	if v, ok := obj[FieldHibernationScheduleInstanceType].(string); ok && v != "" {
+		instanceTypes = append(instanceTypes, inventoryReference{value: v, path: path.GetAttr(FieldHibernationScheduleInstanceType)})
+	}
+
+	return zones, instanceTypes
+}
+
+// hibernationScheduleClusterIDs returns IDs of the clusters assigned to the schedule.
+func hibernationScheduleClusterIDs(clusterAssignments any) []string {
+	list, ok := clusterAssignments.([]any)
+	if !ok || len(list) == 0 || list[0] == nil {
+		return nil
+	}
+
+	assignments, _ := list[0].(map[string]any)[FieldHibernationScheduleAssignment].([]any)
+	return lo.FilterMap(assignments, func(assignment any, _ int) (string, bool) {
+		assignmentMap, ok := assignment.(map[string]any)
+		if !ok {
+			return "", false
+		}
+		clusterID, ok := assignmentMap[FieldHibernationScheduleClusterID].(string)
+		return clusterID, ok
+	})
+}
@@ -751,7 +751,7 @@ func resourceNodeConfigurationCreate(ctx context.Context, d *schema.ResourceData
 
 	resp, err := client.NodeConfigurationAPICreateConfigurationWithResponse(ctx, clusterID, req)
 	if checkErr := sdk.CheckOKResponse(resp, err); checkErr != nil {
-		return diag.FromErr(checkErr)
+		return apiErrorDiagnostics(checkErr, resourceNodeConfiguration().Schema)
 	}
 
 	d.SetId(resp.JSON200.Id)
@@ -923,7 +923,7 @@ func resourceNodeConfigurationUpdate(ctx context.Context, d *schema.ResourceData
 
 	resp, err := client.NodeConfigurationAPIUpdateConfigurationWithResponse(ctx, clusterID, d.Id(), req)
 	if checkErr := sdk.CheckOKResponse(resp, err); checkErr != nil {
-		return diag.FromErr(checkErr)
+		return apiErrorDiagnostics(checkErr, resourceNodeConfiguration().Schema)
 	}
 
 	return resourceNodeConfigurationRead(ctx, d, meta)
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"reflect"
 	"sort"
 	"strings"
@@ -13,7 +14,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/samber/lo"
@@ -100,6 +101,8 @@ const (
 	FieldNodeTemplateCapacityReservationId                    = "id"
 	FieldNodeTemplateCapacityResourceGroupArn                 = "capacity_resource_group_arn"
 	FieldNodeTemplateCapacityReservationType                  = "type"
+	FieldNodeTemplateValidateInstanceTypes                    = "validate_instance_types"
+	FieldNodeTemplateMinMatchingInstanceTypes                 = "min_matching_instance_types"
 )
 
 const (
@@ -175,7 +178,8 @@ func resourceNodeTemplate() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: nodeTemplateStateImporter,
 		},
-		Description: "CAST AI node template resource to manage node templates",
+		Description:   "CAST AI node template resource to manage node templates",
+		CustomizeDiff: customdiff.All(validateNodeTemplateInventory, validateNodeTemplateInstanceTypes),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Minute),
@@ -222,6 +226,22 @@ func resourceNodeTemplate() *schema.Resource {
 				Optional:    true,
 				Description: "Marks whether the templated nodes will have a taint.",
 			},
+			FieldValidateInventory: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Warn on apply when constraints reference availability zones outside the cluster's region or instance types its cloud provider doesn't offer, according to CAST AI inventory.",
+			},
+			FieldNodeTemplateValidateInstanceTypes: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Filter instance types matching the planned constraints through CAST AI during plan. The plan fails when the constraints are rejected, the request fails with another client error, or no instance type matches them.",
+			},
+			FieldNodeTemplateMinMatchingInstanceTypes: {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Description:      "Warn when fewer instance types than this match the constraints, 5 when not set. Only used with `validate_instance_types`. The warning is only logged during plan, it's shown when the node template is applied.",
+			},
 			FieldNodeTemplateConstraints: {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -1316,7 +1336,208 @@ func resourceNodeTemplateDelete(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceNodeTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	return updateNodeTemplate(ctx, d, meta, false)
+	return append(nodeTemplateApplyWarnings(ctx, d, meta), updateNodeTemplate(ctx, d, meta, false)...)
+}
+
+// validateNodeTemplateInventory logs warnings about constraints referencing zones outside the cluster's region or
+// instance types its cloud provider doesn't offer. They are reported on apply by nodeTemplateApplyWarnings. It only runs
+// when the referenced values change, as it calls the API.
+func validateNodeTemplateInventory(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.Get(FieldValidateInventory).(bool) {
+		return nil
+	}
+	if !d.NewValueKnown(FieldClusterId) || !d.NewValueKnown(FieldNodeTemplateConstraints) {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChanges(nodeTemplateInventoryFields...) {
+		return nil
+	}
+
+	logInventoryWarnings(ctx, nodeTemplateInventoryDiagnostics(ctx, meta.(*ProviderConfig).api, d.Get))
+	return nil
+}
+
+// nodeTemplateInventoryFields are the attributes which trigger the inventory validation when changed.
+var nodeTemplateInventoryFields = []string{FieldClusterId, FieldNodeTemplateConstraints, FieldValidateInventory}
+
+// nodeTemplateInventoryDiagnostics checks zones and instance types of the node template built from values returned by
+// get against CAST AI inventory.
+func nodeTemplateInventoryDiagnostics(ctx context.Context, client sdk.ClientWithResponsesInterface, get func(key string) any) diag.Diagnostics {
+	zones, instanceTypes := nodeTemplateInventoryReferences(get(FieldNodeTemplateConstraints))
+	return inventoryDiagnostics(ctx, client, []string{get(FieldClusterId).(string)}, zones, instanceTypes)
+}
+
+// validateNodeTemplateInstanceTypes fails the plan when CAST AI rejects the planned node template or the request, or no
+// instance type matches it. It only runs when the attributes affecting instance types change, as it calls the API.
+func validateNodeTemplateInstanceTypes(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.Get(FieldNodeTemplateValidateInstanceTypes).(bool) {
+		return nil
+	}
+	for _, key := range nodeTemplateInstanceTypeFields {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+	if d.Id() != "" && !d.HasChanges(nodeTemplateInstanceTypeValidationFields...) {
+		return nil
+	}
+
+	diags := nodeTemplateInstanceTypeDiagnostics(ctx, meta.(*ProviderConfig).api, d.Get)
+	if diags.HasError() {
+		return diagnosticsError(diags)
+	}
+	logInventoryWarnings(ctx, diags)
+	return nil
+}
+
+// nodeTemplateApplyWarnings reports warnings of the optional inventory and instance type validations on apply, as
+// warnings of plan time validation are only logged. Same as during plan, each validation only runs when it's enabled and
+// the attributes it checks change.
+func nodeTemplateApplyWarnings(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+
+	var diags diag.Diagnostics
+	if d.Get(FieldValidateInventory).(bool) && d.HasChanges(nodeTemplateInventoryFields...) {
+		diags = append(diags, nodeTemplateInventoryDiagnostics(ctx, client, d.Get)...)
+	}
+	if d.Get(FieldNodeTemplateValidateInstanceTypes).(bool) && d.HasChanges(nodeTemplateInstanceTypeValidationFields...) {
+		for _, diagnostic := range nodeTemplateInstanceTypeDiagnostics(ctx, client, d.Get) {
+			diagnostic.Severity = diag.Warning
+			diags = append(diags, diagnostic)
+		}
+	}
+	return diags
+}
+
+// nodeTemplateInstanceTypeFields are the attributes sent when filtering instance types of a node template.
+var nodeTemplateInstanceTypeFields = []string{
+	FieldClusterId,
+	FieldNodeTemplateConstraints,
+	FieldNodeTemplateCustomTaints,
+	FieldNodeTemplateShouldTaint,
+	FieldNodeTemplateGpu,
+	FieldNodeTemplateCustomInstancesEnabled,
+	FieldNodeTemplateCustomInstancesWithExtendedMemoryEnabled,
+}
+
+// nodeTemplateInstanceTypeValidationFields are the attributes which trigger the instance type validation when changed.
+var nodeTemplateInstanceTypeValidationFields = append([]string{
+	FieldNodeTemplateValidateInstanceTypes,
+	FieldNodeTemplateMinMatchingInstanceTypes,
+}, nodeTemplateInstanceTypeFields...)
+
+// defaultMinMatchingInstanceTypes is used when min_matching_instance_types isn't set.
+const defaultMinMatchingInstanceTypes = 5
+
+// nodeTemplateInstanceTypeDiagnostics filters instance types matching the node template built from values returned by
+// get. It returns an error when the API rejects the template as invalid or nothing matches, and a warning when fewer
+// instance types than the configured minimum match. Other client errors, such as missing permissions or an unknown
+// cluster, are returned as they are. Failing to reach the API results in a warning, as the check is advisory.
+func nodeTemplateInstanceTypeDiagnostics(ctx context.Context, client sdk.ClientWithResponsesInterface, get func(key string) any) diag.Diagnostics {
+	clusterID := get(FieldClusterId).(string)
+	if clusterID == "" {
+		return nil
+	}
+
+	body := sdk.NodeTemplatesAPIFilterInstanceTypesJSONRequestBody{
+		Name:                                     lo.ToPtr(get(FieldNodeTemplateName).(string)),
+		ShouldTaint:                              lo.ToPtr(get(FieldNodeTemplateShouldTaint).(bool)),
+		CustomInstancesEnabled:                   lo.ToPtr(get(FieldNodeTemplateCustomInstancesEnabled).(bool)),
+		CustomInstancesWithExtendedMemoryEnabled: lo.ToPtr(get(FieldNodeTemplateCustomInstancesWithExtendedMemoryEnabled).(bool)),
+	}
+	if v, ok := get(FieldNodeTemplateConstraints).([]any); ok && len(v) > 0 && v[0] != nil {
+		body.Constraints = toTemplateConstraints(v[0].(map[string]any))
+	}
+	if v, ok := get(FieldNodeTemplateGpu).([]any); ok && len(v) > 0 && v[0] != nil {
+		body.Gpu = toTemplateGpu(v[0].(map[string]any))
+	}
+	if v, ok := get(FieldNodeTemplateCustomTaints).([]any); ok && len(v) > 0 {
+		taints := lo.FromPtr(toCustomTaintsWithOptionalEffect(lo.FilterMap(v, func(taint any, _ int) (map[string]any, bool) {
+			t, ok := taint.(map[string]any)
+			return t, ok
+		})))
+		body.CustomTaints = lo.ToPtr(lo.Map(taints, func(t sdk.NodetemplatesV1TaintWithOptionalEffect, _ int) sdk.NodetemplatesV1Taint {
+			return sdk.NodetemplatesV1Taint{Key: lo.ToPtr(t.Key), Value: t.Value, Effect: t.Effect}
+		}))
+	}
+
+	resp, err := client.NodeTemplatesAPIFilterInstanceTypesWithResponse(ctx, clusterID, &sdk.NodeTemplatesAPIFilterInstanceTypesParams{}, body)
+	if checkErr := sdk.CheckOKResponse(resp, err); checkErr != nil {
+		if apiErr, ok := sdk.AsAPIError(checkErr); ok {
+			switch {
+			case apiErr.StatusCode == http.StatusBadRequest || apiErr.StatusCode == http.StatusUnprocessableEntity:
+				return apiErrorDiagnostics(fmt.Errorf("node template was rejected by CAST AI: %w", checkErr), resourceNodeTemplate().Schema)
+			case apiErr.StatusCode >= 400 && apiErr.StatusCode < 500 && apiErr.StatusCode != http.StatusTooManyRequests:
+				return diag.FromErr(fmt.Errorf("filtering node template instance types: %w", checkErr))
+			}
+		}
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Could not validate node template instance types",
+			Detail:   checkErr.Error(),
+		}}
+	}
+
+	matching := len(lo.UniqBy(lo.FromPtr(resp.JSON200.AvailableInstanceTypes), func(it sdk.NodetemplatesV1AvailableInstanceType) string {
+		return lo.FromPtr(it.Name)
+	}))
+	minMatching, _ := get(FieldNodeTemplateMinMatchingInstanceTypes).(int)
+	if minMatching == 0 {
+		minMatching = defaultMinMatchingInstanceTypes
+	}
+	switch {
+	case matching == 0:
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "No instance types match the node template constraints",
+			Detail:        "Nodes can't be created for the node template. Relax the constraints, or check the castai_instance_types data source for instance types available in the cluster.",
+			AttributePath: cty.GetAttrPath(FieldNodeTemplateConstraints),
+		}}
+	case matching < minMatching:
+		return diag.Diagnostics{{
+			Severity:      diag.Warning,
+			Summary:       fmt.Sprintf("Only %d instance types match the node template constraints, fewer than %d", matching, minMatching),
+			Detail:        "Few matching instance types limit the autoscaler's options, which may lead to higher cost or nodes failing to be created when the instance types are unavailable.",
+			AttributePath: cty.GetAttrPath(FieldNodeTemplateConstraints),
+		}}
+	}
+
+	return nil
+}
+
+// nodeTemplateInventoryReferences collects zones and instance types referenced by node template constraints.
+func nodeTemplateInventoryReferences(constraints any) (zones, instanceTypes []inventoryReference) {
+	list, ok := constraints.([]any)
+	if !ok || len(list) == 0 || list[0] == nil {
+		return nil, nil
+	}
+	obj := list[0].(map[string]any)
+	path := cty.GetAttrPath(FieldNodeTemplateConstraints).IndexInt(0)
+
+	if v, ok := obj[FieldNodeTemplateAZs].([]any); ok {
+		for _, zone := range toStringList(v) {
+			zones = append(zones, inventoryReference{value: zone, path: path.GetAttr(FieldNodeTemplateAZs)})
+		}
+	}
+	if v, ok := obj[FieldNodeTemplateDedicatedNodeAffinity].([]any); ok {
+		for i, affinity := range v {
+			affinity, ok := affinity.(map[string]any)
+			if !ok {
+				continue
+			}
+			affinityPath := path.GetAttr(FieldNodeTemplateDedicatedNodeAffinity).IndexInt(i)
+			if zone, ok := affinity[FieldNodeTemplateAzName].(string); ok {
+				zones = append(zones, inventoryReference{value: zone, path: affinityPath.GetAttr(FieldNodeTemplateAzName)})
+			}
+			if types, ok := affinity[FieldNodeTemplateInstanceTypes].([]any); ok {
+				for _, instanceType := range toStringList(types) {
+					instanceTypes = append(instanceTypes, inventoryReference{value: instanceType, path: affinityPath.GetAttr(FieldNodeTemplateInstanceTypes)})
+				}
+			}
+		}
+	}
+
+	return zones, instanceTypes
 }
 
 func updateNodeTemplate(ctx context.Context, d *schema.ResourceData, meta any, skipChangeCheck bool) diag.Diagnostics {
@@ -1434,7 +1655,7 @@ func updateNodeTemplate(ctx context.Context, d *schema.ResourceData, meta any, s
 
 	resp, err := client.NodeTemplatesAPIUpdateNodeTemplateWithResponse(ctx, clusterID, name, req)
 	if checkErr := sdk.CheckOKResponse(resp, err); checkErr != nil {
-		return diag.FromErr(checkErr)
+		return apiErrorDiagnostics(checkErr, resourceNodeTemplate().Schema)
 	}
 
 	return resourceNodeTemplateRead(ctx, d, meta)
@@ -1452,7 +1673,7 @@ func resourceNodeTemplateCreate(ctx context.Context, d *schema.ResourceData, met
 	// Since name of the default node template is fixed, we can use it to identify the default node template. All other
 	// requests should be treated as regular node template creation requests to avoid conflicts for validation of the request.
 	if name == "default-by-castai" {
-		return updateDefaultNodeTemplate(ctx, d, meta)
+		return append(nodeTemplateApplyWarnings(ctx, d, meta), updateDefaultNodeTemplate(ctx, d, meta)...)
 	}
 
 	req := sdk.NodeTemplatesAPICreateNodeTemplateJSONRequestBody{
@@ -1519,12 +1740,12 @@ func resourceNodeTemplateCreate(ctx context.Context, d *schema.ResourceData, met
 
 	resp, err := client.NodeTemplatesAPICreateNodeTemplateWithResponse(ctx, clusterID, req)
 	if checkErr := sdk.CheckOKResponse(resp, err); checkErr != nil {
-		return diag.FromErr(checkErr)
+		return apiErrorDiagnostics(checkErr, resourceNodeTemplate().Schema)
 	}
 
 	d.SetId(lo.FromPtr(resp.JSON200.Name))
 
-	return resourceNodeTemplateRead(ctx, d, meta)
+	return append(nodeTemplateApplyWarnings(ctx, d, meta), resourceNodeTemplateRead(ctx, d, meta)...)
 }
 
 func updateDefaultNodeTemplate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -1532,18 +1753,18 @@ func updateDefaultNodeTemplate(ctx context.Context, d *schema.ResourceData, meta
 	// make timeout 5 seconds less than the creation timeout
 	timeout := d.Timeout(schema.TimeoutCreate) - 5*time.Second
 	// handle situation when default node template is not created yet by autoscaler policy
-	if err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+	if err := waitFor(ctx, fmt.Sprintf("default node template %s update", d.Id()), timeout, func(ctx context.Context) (bool, string, error) {
 		diagnostics := updateNodeTemplate(ctx, d, meta, true)
 
 		for _, d := range diagnostics {
 			if d.Severity == diag.Error {
 				if strings.Contains(d.Summary, "node template not found") {
-					return retry.RetryableError(fmt.Errorf("%s", d.Summary))
+					return false, d.Summary, nil
 				}
-				return retry.NonRetryableError(fmt.Errorf("%s", d.Summary))
+				return false, "", fmt.Errorf("%s", d.Summary)
 			}
 		}
-		return nil
+		return true, "updated", nil
 	}); err != nil {
 		return diag.FromErr(err)
 	}
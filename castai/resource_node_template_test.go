@@ -713,6 +713,214 @@ func TestNodeTemplateResourceCreate_customNodeTemplate(t *testing.T) {
 	r.False(result.HasError())
 }
 
+func TestNodeTemplateResourceCreate_fieldViolations(t *testing.T) {
+	r := require.New(t)
+	mockctrl := gomock.NewController(t)
+	mockClient := mock_sdk.NewMockClientInterface(mockctrl)
+
+	ctx := context.Background()
+	provider := &ProviderConfig{
+		api: &sdk.ClientWithResponses{
+			ClientInterface: mockClient,
+		},
+	}
+
+	name := "custom-template"
+	clusterId := "b6bfc074-a267-400f-b8f1-db0850c369b1"
+	errorResponse := `{
+		"message": "Bad Request",
+		"fieldViolations": [
+			{"field": "constraints.instanceFamilies.include", "description": "unknown instance family \"x9\""},
+			{"field": "customTaints[0].key", "description": "key must not be empty"}
+		]
+	}`
+
+	mockClient.EXPECT().
+		NodeTemplatesAPICreateNodeTemplate(gomock.Any(), clusterId, gomock.Any()).
+		Return(&http.Response{StatusCode: 400, Body: io.NopCloser(bytes.NewReader([]byte(errorResponse))), Header: map[string][]string{"Content-Type": {"json"}}}, nil)
+
+	resource := resourceNodeTemplate()
+	val := cty.ObjectVal(map[string]cty.Value{
+		FieldClusterId:             cty.StringVal(clusterId),
+		FieldNodeTemplateName:      cty.StringVal(name),
+		FieldNodeTemplateIsEnabled: cty.BoolVal(true),
+	})
+	state := sdkterraform.NewInstanceStateShimmedFromValue(val, 0)
+
+	data := resource.Data(state)
+	result := resource.CreateContext(ctx, data, provider)
+	r.True(result.HasError())
+	r.Len(result, 3)
+	r.Contains(result[0].Summary, "expected status code 200, received: status=400")
+	r.Nil(result[0].AttributePath)
+
+	r.Equal(`unknown instance family "x9"`, result[1].Detail)
+	r.Equal(cty.GetAttrPath(FieldNodeTemplateConstraints).IndexInt(0).GetAttr(FieldNodeTemplateInstanceFamilies).IndexInt(0).GetAttr(FieldNodeTemplateInclude), result[1].AttributePath)
+
+	r.Equal("key must not be empty", result[2].Detail)
+	r.Equal(cty.GetAttrPath(FieldNodeTemplateCustomTaints).IndexInt(0).GetAttr(FieldKey), result[2].AttributePath)
+}
+
+func TestNodeTemplateResourceDiff_validateInstanceTypes(t *testing.T) {
+	clusterId := "b6bfc074-a267-400f-b8f1-db0850c369b1"
+	raw := map[string]any{
+		FieldClusterId:                         clusterId,
+		FieldNodeTemplateName:                  "gpu",
+		FieldNodeTemplateValidateInstanceTypes: true,
+		FieldNodeTemplateConstraints: []any{map[string]any{
+			FieldNodeTemplateInstanceFamilies: []any{map[string]any{
+				FieldNodeTemplateInclude: []any{"x9"},
+			}},
+		}},
+	}
+
+	tests := map[string]struct {
+		statusCode    int
+		body          string
+		expectedError string
+	}{
+		"should fail when no instance types match": {
+			statusCode:    http.StatusOK,
+			body:          `{"availableInstanceTypes": []}`,
+			expectedError: "constraints: No instance types match the node template constraints",
+		},
+		"should fail with attribute of rejected constraint": {
+			statusCode:    http.StatusBadRequest,
+			body:          `{"message": "Bad Request", "fieldViolations": [{"field": "constraints.instanceFamilies.include", "description": "unknown instance family \"x9\""}]}`,
+			expectedError: `constraints.0.instance_families.0.include: Invalid value for "constraints.instanceFamilies.include": unknown instance family "x9"`,
+		},
+		"should pass when few instance types match": {
+			statusCode: http.StatusOK,
+			body:       `{"availableInstanceTypes": [{"name": "x9.large", "zone": "a"}, {"name": "x9.large", "zone": "b"}]}`,
+		},
+		"should pass when the API is unavailable": {
+			statusCode: http.StatusInternalServerError,
+			body:       `{"message": "internal error"}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+			mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+			provider := &ProviderConfig{
+				api: &sdk.ClientWithResponses{
+					ClientInterface: mockClient,
+				},
+			}
+
+			mockClient.EXPECT().
+				NodeTemplatesAPIFilterInstanceTypes(gomock.Any(), clusterId, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, _ *sdk.NodeTemplatesAPIFilterInstanceTypesParams, body sdk.NodeTemplatesAPIFilterInstanceTypesJSONRequestBody, _ ...sdk.RequestEditorFn) (*http.Response, error) {
+					r.Equal([]string{"x9"}, lo.FromPtr(body.Constraints.InstanceFamilies.Include))
+					return &http.Response{StatusCode: test.statusCode, Body: io.NopCloser(bytes.NewReader([]byte(test.body))), Header: map[string][]string{"Content-Type": {"json"}}}, nil
+				}).
+				MinTimes(1)
+
+			resource := resourceNodeTemplate()
+			_, err := resource.Diff(context.Background(), nil, sdkterraform.NewResourceConfigRaw(raw), provider)
+			if test.expectedError == "" {
+				r.NoError(err)
+				return
+			}
+			r.ErrorContains(err, test.expectedError)
+		})
+	}
+}
+
+func Test_nodeTemplateInstanceTypeDiagnostics(t *testing.T) {
+	clusterId := "b6bfc074-a267-400f-b8f1-db0850c369b1"
+	twoInstanceTypes := `{"availableInstanceTypes": [{"name": "m5.large"}, {"name": "m5.xlarge"}]}`
+
+	tests := map[string]struct {
+		minMatching      any
+		statusCode       int
+		body             string
+		expectedSeverity diag.Severity
+		expectedSummary  string
+	}{
+		"should warn below configured minimum": {
+			minMatching:      3,
+			statusCode:       http.StatusOK,
+			body:             twoInstanceTypes,
+			expectedSeverity: diag.Warning,
+			expectedSummary:  "Only 2 instance types match the node template constraints, fewer than 3",
+		},
+		"should warn below default minimum when not set": {
+			minMatching:      0,
+			statusCode:       http.StatusOK,
+			body:             twoInstanceTypes,
+			expectedSeverity: diag.Warning,
+			expectedSummary:  "Only 2 instance types match the node template constraints, fewer than 5",
+		},
+		"should report invalid template as rejected": {
+			statusCode:       http.StatusBadRequest,
+			body:             `{"message": "invalid constraints"}`,
+			expectedSeverity: diag.Error,
+			expectedSummary:  `node template was rejected by CAST AI: expected status code 200, received: status=400 body={"message": "invalid constraints"}`,
+		},
+		"should report other client errors as they are": {
+			statusCode:       http.StatusForbidden,
+			body:             `{"message": "forbidden"}`,
+			expectedSeverity: diag.Error,
+			expectedSummary:  `filtering node template instance types: expected status code 200, received: status=403 body={"message": "forbidden"}`,
+		},
+		"should warn when API is unavailable": {
+			statusCode:       http.StatusServiceUnavailable,
+			body:             `{}`,
+			expectedSeverity: diag.Warning,
+			expectedSummary:  "Could not validate node template instance types",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+			mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+
+			mockClient.EXPECT().
+				NodeTemplatesAPIFilterInstanceTypes(gomock.Any(), clusterId, gomock.Any(), gomock.Any()).
+				Return(&http.Response{StatusCode: test.statusCode, Body: io.NopCloser(bytes.NewReader([]byte(test.body))), Header: map[string][]string{"Content-Type": {"json"}}}, nil)
+
+			values := map[string]any{
+				FieldClusterId:                                            clusterId,
+				FieldNodeTemplateName:                                     "template",
+				FieldNodeTemplateShouldTaint:                              true,
+				FieldNodeTemplateCustomInstancesEnabled:                   false,
+				FieldNodeTemplateCustomInstancesWithExtendedMemoryEnabled: false,
+				FieldNodeTemplateMinMatchingInstanceTypes:                 test.minMatching,
+			}
+			diags := nodeTemplateInstanceTypeDiagnostics(context.Background(), &sdk.ClientWithResponses{ClientInterface: mockClient}, func(key string) any {
+				return values[key]
+			})
+			r.Len(diags, 1)
+			r.Equal(test.expectedSeverity, diags[0].Severity)
+			r.Equal(test.expectedSummary, diags[0].Summary)
+		})
+	}
+}
+
+func Test_nodeTemplateApplyWarnings(t *testing.T) {
+	r := require.New(t)
+	// The API isn't called, as none of the attributes affecting instance types changed.
+	provider := &ProviderConfig{
+		api: &sdk.ClientWithResponses{
+			ClientInterface: mock_sdk.NewMockClientInterface(gomock.NewController(t)),
+		},
+	}
+
+	state := sdkterraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{
+		FieldClusterId:                         cty.StringVal("b6bfc074-a267-400f-b8f1-db0850c369b1"),
+		FieldNodeTemplateName:                  cty.StringVal("template"),
+		FieldNodeTemplateValidateInstanceTypes: cty.BoolVal(true),
+	}), 0)
+	state.ID = "template"
+	data := resourceNodeTemplate().Data(state)
+	r.NoError(data.Set(FieldNodeTemplateIsEnabled, false))
+
+	r.Nil(nodeTemplateApplyWarnings(context.Background(), data, provider))
+}
+
 func TestNodeTemplateResourceDelete_defaultNodeTemplate(t *testing.T) {
 	r := require.New(t)
 	mockctrl := gomock.NewController(t)
@@ -244,7 +244,7 @@ func resourceRebalancingScheduleCreate(ctx context.Context, d *schema.ResourceDa
 
 	resp, err := client.ScheduledRebalancingAPICreateRebalancingScheduleWithResponse(ctx, req)
 	if checkErr := sdk.CheckOKResponse(resp, err); checkErr != nil {
-		return append(diags, diag.FromErr(checkErr)...)
+		return append(diags, apiErrorDiagnostics(checkErr, resourceRebalancingSchedule().Schema)...)
 	}
 
 	d.SetId(*resp.JSON200.Id)
@@ -290,7 +290,7 @@ func resourceRebalancingScheduleUpdate(ctx context.Context, d *schema.ResourceDa
 		Id: lo.ToPtr(d.Id()),
 	}, req)
 	if checkErr := sdk.CheckOKResponse(resp, err); checkErr != nil {
-		return append(diags, diag.FromErr(checkErr)...)
+		return append(diags, apiErrorDiagnostics(checkErr, resourceRebalancingSchedule().Schema)...)
 	}
 	return append(diags, resourceRebalancingScheduleRead(ctx, d, meta)...)
 }
@@ -6,10 +6,12 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
 
 	"github.com/castai/terraform-provider-castai/castai/sdk"
 )
@@ -33,6 +35,9 @@ func resourceServiceAccount() *schema.Resource {
 		ReadContext:   resourceServiceAccountRead,
 		UpdateContext: resourceServiceAccountUpdate,
 		DeleteContext: resourceServiceAccountDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: serviceAccountStateImporter,
+		},
 
 		Description: "Service account resource allows managing CAST AI service accounts.",
 		Timeouts: &schema.ResourceTimeout{
@@ -250,6 +255,69 @@ func resourceServiceAccountDelete(ctx context.Context, data *schema.ResourceData
 	return nil
 }
 
+// serviceAccountStateImporter imports a service account by ID or name. The organization can be given in format
+// <organization id>/<service account id|service account name>, otherwise the default organization is used.
+func serviceAccountStateImporter(ctx context.Context, data *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	organizationID, id := parseImportID(data)
+	if organizationID == "" {
+		defaultOrganizationID, err := getDefaultOrganizationId(ctx, meta)
+		if err != nil {
+			return nil, fmt.Errorf("getting organization ID: %w", err)
+		}
+		organizationID = defaultOrganizationID
+	}
+
+	if _, err := uuid.Parse(id); err != nil {
+		tflog.Info(ctx, "provided service account ID is not a UUID, will import by name")
+
+		serviceAccountID, err := findServiceAccountIDByName(ctx, meta.(*ProviderConfig).api, organizationID, id)
+		if err != nil {
+			return nil, err
+		}
+		id = serviceAccountID
+	}
+
+	data.SetId(id)
+	if err := data.Set(FieldServiceAccountOrganizationID, organizationID); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{data}, nil
+}
+
+func findServiceAccountIDByName(ctx context.Context, client sdk.ClientWithResponsesInterface, organizationID, name string) (string, error) {
+	var ids []string
+	var cursor *string
+	for {
+		resp, err := client.ServiceAccountsAPIListServiceAccountsWithResponse(ctx, organizationID, &sdk.ServiceAccountsAPIListServiceAccountsParams{
+			PageCursor: cursor,
+		})
+		if err := sdk.CheckOKResponse(resp, err); err != nil {
+			return "", fmt.Errorf("listing service accounts: %w", err)
+		}
+
+		for _, serviceAccount := range lo.FromPtr(resp.JSON200.ServiceAccounts) {
+			if lo.FromPtr(serviceAccount.Name) == name {
+				ids = append(ids, lo.FromPtr(serviceAccount.Id))
+			}
+		}
+
+		if lo.FromPtr(resp.JSON200.NextPage.Cursor) == "" {
+			break
+		}
+		cursor = resp.JSON200.NextPage.Cursor
+	}
+
+	switch {
+	case len(ids) == 0:
+		return "", fmt.Errorf("could not find service account with name %q in organization %s", name, organizationID)
+	case len(ids) > 1:
+		return "", fmt.Errorf("found %d service accounts named %q in organization %s, import by service account ID instead", len(ids), name, organizationID)
+	}
+
+	return ids[0], nil
+}
+
 func flattenServiceAccountAuthor(author *sdk.CastaiServiceaccountsV1beta1ServiceAccountAuthor) []map[string]interface{} {
 	if author == nil {
 		return []map[string]interface{}{}
@@ -2,13 +2,17 @@ package castai
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
 
 	"github.com/castai/terraform-provider-castai/castai/sdk"
 )
@@ -32,6 +36,9 @@ func resourceServiceAccountKey() *schema.Resource {
 		ReadContext:   resourceServiceAccountKeyRead,
 		UpdateContext: resourceServiceAccountKeyUpdate,
 		DeleteContext: resourceServiceAccountKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: serviceAccountKeyStateImporter,
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(3 * time.Minute),
 			Read:   schema.DefaultTimeout(3 * time.Minute),
@@ -171,6 +178,91 @@ func resourceServiceAccountKeyRead(ctx context.Context, data *schema.ResourceDat
 	return nil
 }
 
+// serviceAccountKeyStateImporter imports a key by ID or name in format
+// [<organization id>/]<service account id|service account name>/<key id|key name>. The default organization is used
+// when the organization is omitted. The key token is only returned on creation and stays empty after import.
+func serviceAccountKeyStateImporter(ctx context.Context, data *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*ProviderConfig).api
+
+	var organizationID, serviceAccountID, keyID string
+	switch parts := strings.Split(data.Id(), "/"); len(parts) {
+	case 2:
+		serviceAccountID, keyID = parts[0], parts[1]
+		defaultOrganizationID, err := getDefaultOrganizationId(ctx, meta)
+		if err != nil {
+			return nil, fmt.Errorf("getting organization ID: %w", err)
+		}
+		organizationID = defaultOrganizationID
+	case 3:
+		organizationID, serviceAccountID, keyID = parts[0], parts[1], parts[2]
+	default:
+		return nil, fmt.Errorf("invalid import ID %q, expected [<organization id>/]<service account id>/<key id>", data.Id())
+	}
+
+	if _, err := uuid.Parse(serviceAccountID); err != nil {
+		tflog.Info(ctx, "provided service account ID is not a UUID, will import by name")
+
+		id, err := findServiceAccountIDByName(ctx, client, organizationID, serviceAccountID)
+		if err != nil {
+			return nil, err
+		}
+		serviceAccountID = id
+	}
+
+	if _, err := uuid.Parse(keyID); err != nil {
+		tflog.Info(ctx, "provided service account key ID is not a UUID, will import by name")
+
+		id, err := findServiceAccountKeyIDByName(ctx, client, organizationID, serviceAccountID, keyID)
+		if err != nil {
+			return nil, err
+		}
+		keyID = id
+	}
+
+	data.SetId(keyID)
+	if err := data.Set(FieldServiceAccountKeyOrganizationID, organizationID); err != nil {
+		return nil, err
+	}
+	if err := data.Set(FieldServiceAccountKeyServiceAccountID, serviceAccountID); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{data}, nil
+}
+
+func findServiceAccountKeyIDByName(ctx context.Context, client sdk.ClientWithResponsesInterface, organizationID, serviceAccountID, name string) (string, error) {
+	var ids []string
+	var cursor *string
+	for {
+		resp, err := client.ServiceAccountsAPIListServiceAccountKeysWithResponse(ctx, organizationID, serviceAccountID, &sdk.ServiceAccountsAPIListServiceAccountKeysParams{
+			PageCursor: cursor,
+		})
+		if err := sdk.CheckOKResponse(resp, err); err != nil {
+			return "", fmt.Errorf("listing service account keys: %w", err)
+		}
+
+		for _, key := range lo.FromPtr(resp.JSON200.Keys) {
+			if lo.FromPtr(key.Name) == name {
+				ids = append(ids, lo.FromPtr(key.Id))
+			}
+		}
+
+		if lo.FromPtr(resp.JSON200.NextPage.Cursor) == "" {
+			break
+		}
+		cursor = resp.JSON200.NextPage.Cursor
+	}
+
+	switch {
+	case len(ids) == 0:
+		return "", fmt.Errorf("could not find key with name %q for service account %s", name, serviceAccountID)
+	case len(ids) > 1:
+		return "", fmt.Errorf("found %d keys named %q for service account %s, import by key ID instead", len(ids), name, serviceAccountID)
+	}
+
+	return ids[0], nil
+}
+
 func resourceServiceAccountKeyCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var expiresAtTime *time.Time
 
@@ -590,3 +590,55 @@ Tainted = false
 `, data.State().String())
 	})
 }
+
+func TestServiceAccountKey_Import(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when importing by key name then resolve key ID and leave token empty", func(t *testing.T) {
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+
+		ctx := context.Background()
+		provider := &ProviderConfig{
+			api: &sdk.ClientWithResponses{
+				ClientInterface: mockClient,
+			},
+		}
+
+		organizationID := "4e4cd9eb-82eb-407e-a926-e5fef81cab50"
+		serviceAccountID := "b11f5945-22ca-4101-a86e-d6e37f44a415"
+		keyID := "da5664b3-87bf-4e03-9d1c-ec26049991b7"
+
+		body := fmt.Sprintf(`{"keys":[{"id":"%s","name":"ci-key"},{"id":"0a1b2c3d-87bf-4e03-9d1c-ec26049991b7","name":"other"}],"nextPage":{}}`, keyID)
+		mockClient.EXPECT().
+			ServiceAccountsAPIListServiceAccountKeys(gomock.Any(), organizationID, serviceAccountID, &sdk.ServiceAccountsAPIListServiceAccountKeysParams{}).
+			Return(&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader([]byte(body))), Header: map[string][]string{"Content-Type": {"json"}}}, nil)
+
+		state := terraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{}), 0)
+		state.ID = fmt.Sprintf("%s/%s/ci-key", organizationID, serviceAccountID)
+
+		resource := resourceServiceAccountKey()
+		data := resource.Data(state)
+
+		result, err := resource.Importer.StateContext(ctx, data, provider)
+		r.NoError(err)
+		r.Len(result, 1)
+		r.Equal(keyID, result[0].Id())
+		r.Equal(organizationID, result[0].Get(FieldServiceAccountKeyOrganizationID))
+		r.Equal(serviceAccountID, result[0].Get(FieldServiceAccountKeyServiceAccountID))
+		r.Empty(result[0].Get(FieldServiceAccountKeyToken))
+	})
+
+	t.Run("when import ID has invalid format then return error", func(t *testing.T) {
+		r := require.New(t)
+
+		state := terraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{}), 0)
+		state.ID = "da5664b3-87bf-4e03-9d1c-ec26049991b7"
+
+		resource := resourceServiceAccountKey()
+		data := resource.Data(state)
+
+		_, err := resource.Importer.StateContext(context.Background(), data, &ProviderConfig{})
+		r.ErrorContains(err, "invalid import ID")
+	})
+}
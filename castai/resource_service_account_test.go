@@ -604,3 +604,65 @@ func TestServiceAccount_UpdateContext(t *testing.T) {
 		r.Equal("new description", data.Get("description"))
 	})
 }
+
+func TestServiceAccount_Import(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when importing by name then resolve service account ID", func(t *testing.T) {
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+
+		ctx := context.Background()
+		provider := &ProviderConfig{
+			api: &sdk.ClientWithResponses{
+				ClientInterface: mockClient,
+			},
+		}
+
+		organizationID := "4e4cd9eb-82eb-407e-a926-e5fef81cab50"
+		serviceAccountID := "b11f5945-22ca-4101-a86e-d6e37f44a415"
+
+		firstPage := `{"serviceAccounts":[{"id":"d7a4f2c9-8c41-4e1e-b1a5-0e0c5e8f2f10","name":"other"}],"nextPage":{"cursor":"next"}}`
+		secondPage := fmt.Sprintf(`{"serviceAccounts":[{"id":"%s","name":"ci"}],"nextPage":{}}`, serviceAccountID)
+
+		gomock.InOrder(
+			mockClient.EXPECT().
+				ServiceAccountsAPIListServiceAccounts(gomock.Any(), organizationID, &sdk.ServiceAccountsAPIListServiceAccountsParams{}).
+				Return(&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader([]byte(firstPage))), Header: map[string][]string{"Content-Type": {"json"}}}, nil),
+			mockClient.EXPECT().
+				ServiceAccountsAPIListServiceAccounts(gomock.Any(), organizationID, &sdk.ServiceAccountsAPIListServiceAccountsParams{PageCursor: toPtr("next")}).
+				Return(&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader([]byte(secondPage))), Header: map[string][]string{"Content-Type": {"json"}}}, nil),
+		)
+
+		state := terraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{}), 0)
+		state.ID = organizationID + "/ci"
+
+		resource := resourceServiceAccount()
+		data := resource.Data(state)
+
+		result, err := resource.Importer.StateContext(ctx, data, provider)
+		r.NoError(err)
+		r.Len(result, 1)
+		r.Equal(serviceAccountID, result[0].Id())
+		r.Equal(organizationID, result[0].Get(FieldServiceAccountOrganizationID))
+	})
+
+	t.Run("when importing by ID then skip lookup", func(t *testing.T) {
+		r := require.New(t)
+
+		organizationID := "4e4cd9eb-82eb-407e-a926-e5fef81cab50"
+		serviceAccountID := "b11f5945-22ca-4101-a86e-d6e37f44a415"
+
+		state := terraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{}), 0)
+		state.ID = organizationID + "/" + serviceAccountID
+
+		resource := resourceServiceAccount()
+		data := resource.Data(state)
+
+		result, err := resource.Importer.StateContext(context.Background(), data, &ProviderConfig{})
+		r.NoError(err)
+		r.Len(result, 1)
+		r.Equal(serviceAccountID, result[0].Id())
+		r.Equal(organizationID, result[0].Get(FieldServiceAccountOrganizationID))
+	})
+}
@@ -7,9 +7,12 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/castai/terraform-provider-castai/castai/sdk"
@@ -46,7 +49,10 @@ func resourceSSOConnection() *schema.Resource {
 		UpdateContext: resourceCastaiSSOConnectionUpdate,
 		DeleteContext: resourceCastaiSSOConnectionDelete,
 		CustomizeDiff: resourceCastaiSSOConnectionDiff,
-		Description:   "SSO Connection resource allows creating SSO trust relationship with CAST AI.",
+		Importer: &schema.ResourceImporter{
+			StateContext: ssoConnectionStateImporter,
+		},
+		Description: "SSO Connection resource allows creating SSO trust relationship with CAST AI.",
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(3 * time.Minute),
 			Update: schema.DefaultTimeout(3 * time.Minute),
@@ -308,6 +314,79 @@ func resourceCastaiSSOConnectionRead(ctx context.Context, data *schema.ResourceD
 	return nil
 }
 
+// ssoConnectionStateImporter imports a connection by ID or name. Connector blocks are populated from the API, which
+// returns client secrets as base64 encoded bcrypt hashes, so that the client_secret diff suppression matches the
+// configured plaintext secret and no spurious diff is produced after import.
+func ssoConnectionStateImporter(ctx context.Context, data *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*ProviderConfig).api
+
+	_, id := parseImportID(data)
+	if _, err := uuid.Parse(id); err != nil {
+		tflog.Info(ctx, "provided SSO connection ID is not a UUID, will import by name")
+
+		resp, err := client.SSOAPIListSSOConnectionsWithResponse(ctx)
+		if err := sdk.CheckOKResponse(resp, err); err != nil {
+			return nil, fmt.Errorf("listing sso connections: %w", err)
+		}
+
+		connections := lo.Filter(resp.JSON200.Connections, func(c sdk.CastaiSsoV1beta1SSOConnection, _ int) bool {
+			return c.Name == id
+		})
+		switch {
+		case len(connections) == 0:
+			return nil, fmt.Errorf("could not find sso connection with name %q", id)
+		case len(connections) > 1:
+			return nil, fmt.Errorf("found %d sso connections named %q, import by connection ID instead", len(connections), id)
+		}
+		id = lo.FromPtr(connections[0].Id)
+	}
+
+	resp, err := client.SSOAPIGetSSOConnectionWithResponse(ctx, id)
+	if err := sdk.CheckOKResponse(resp, err); err != nil {
+		return nil, fmt.Errorf("retrieving sso connection: %w", err)
+	}
+
+	data.SetId(id)
+	if err := setSSOConnectors(data, resp.JSON200.Connection); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{data}, nil
+}
+
+func setSSOConnectors(data *schema.ResourceData, connection *sdk.CastaiSsoV1beta1SSOConnection) error {
+	if connection.Aad != nil {
+		if err := data.Set(FieldSSOConnectionAAD, []map[string]any{{
+			FieldSSOConnectionADDomain:       connection.Aad.AdDomain,
+			FieldSSOConnectionADClientID:     connection.Aad.ClientId,
+			FieldSSOConnectionADClientSecret: lo.FromPtr(connection.Aad.ClientSecret),
+		}}); err != nil {
+			return fmt.Errorf("setting aad connector: %w", err)
+		}
+	}
+	if connection.Okta != nil {
+		if err := data.Set(FieldSSOConnectionOkta, []map[string]any{{
+			FieldSSOConnectionOktaDomain:       connection.Okta.OktaDomain,
+			FieldSSOConnectionOktaClientID:     connection.Okta.ClientId,
+			FieldSSOConnectionOktaClientSecret: lo.FromPtr(connection.Okta.ClientSecret),
+		}}); err != nil {
+			return fmt.Errorf("setting okta connector: %w", err)
+		}
+	}
+	if connection.Oidc != nil {
+		if err := data.Set(FieldSSOConnectionOIDC, []map[string]any{{
+			FieldSSOConnectionOIDCIssuerURL:    connection.Oidc.IssuerUrl,
+			FieldSSOConnectionOIDCClientID:     connection.Oidc.ClientId,
+			FieldSSOConnectionOIDCClientSecret: lo.FromPtr(connection.Oidc.ClientSecret),
+			FieldSSOConnectionOIDCType:         string(connection.Oidc.Type),
+		}}); err != nil {
+			return fmt.Errorf("setting oidc connector: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func resourceCastaiSSOConnectionUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if !data.HasChanges(
 		FieldSSOConnectionName,
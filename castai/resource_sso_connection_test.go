@@ -1319,3 +1319,45 @@ func equalADConnector(t *testing.T, r *require.Assertions, in interface{}, expec
 	r.True(ok)
 	r.Equal(expectedClientSecret, clientSecret)
 }
+
+func TestSSOConnection_Import(t *testing.T) {
+	t.Parallel()
+	r := require.New(t)
+
+	plaintext := "super-secret"
+	hash, err := bcrypt.GenerateFromPassword([]byte(plaintext), bcrypt.MinCost)
+	r.NoError(err)
+	encoded := base64.StdEncoding.EncodeToString(hash)
+
+	connectionID := "fce35ba2-5c06-4078-8391-1ac8f7ba798b"
+	listBody := fmt.Sprintf(`{"connections":[{"id":"%s","name":"test_sso","emailDomain":"test_email"},{"id":"7f0b1c59-3d56-4c4c-9a3c-3f4a8b0a2d11","name":"other","emailDomain":"other_email"}]}`, connectionID)
+	readBody := fmt.Sprintf(`{"connection":{"id":"%s","name":"test_sso","emailDomain":"test_email","aad":{"adDomain":"test_connector","clientId":"test_client","clientSecret":"%s"}}}`, connectionID, encoded)
+
+	mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+	mockClient.EXPECT().
+		SSOAPIListSSOConnections(gomock.Any()).
+		Return(&http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(listBody))), Header: map[string][]string{"Content-Type": {"json"}}}, nil)
+	mockClient.EXPECT().
+		SSOAPIGetSSOConnection(gomock.Any(), connectionID).
+		Return(&http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewReader([]byte(readBody))), Header: map[string][]string{"Content-Type": {"json"}}}, nil)
+
+	resource := resourceSSOConnection()
+	state := sdkterraform.NewInstanceStateShimmedFromValue(cty.ObjectVal(map[string]cty.Value{}), 0)
+	state.ID = "test_sso"
+	data := resource.Data(state)
+
+	result, err := resource.Importer.StateContext(context.Background(), data, &ProviderConfig{
+		api: &sdk.ClientWithResponses{
+			ClientInterface: mockClient,
+		},
+	})
+	r.NoError(err)
+	r.Len(result, 1)
+	r.Equal(connectionID, result[0].Id())
+	r.Equal("test_connector", result[0].Get("aad.0.ad_domain"))
+	r.Equal("test_client", result[0].Get("aad.0.client_id"))
+
+	// The imported hash must suppress the diff against the configured plaintext secret.
+	suppress := resource.Schema[FieldSSOConnectionAAD].Elem.(*schema.Resource).Schema[FieldSSOConnectionADClientSecret].DiffSuppressFunc
+	r.True(suppress(FieldSSOConnectionADClientSecret, result[0].Get("aad.0.client_secret").(string), plaintext, nil))
+}
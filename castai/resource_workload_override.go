@@ -0,0 +1,465 @@
+package castai
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+)
+
+const (
+	FieldWorkloadOverrideNamespace        = "namespace"
+	FieldWorkloadOverrideKind             = "kind"
+	FieldWorkloadOverrideName             = "name"
+	FieldWorkloadOverrideWorkloadID       = "workload_id"
+	FieldWorkloadOverrideScalingPolicyID  = "scaling_policy_id"
+	FieldWorkloadOverrideVertical         = "vertical"
+	FieldWorkloadOverrideHorizontal       = "horizontal"
+	FieldWorkloadOverrideManagementOption = "management_option"
+	FieldWorkloadOverrideCpu              = "cpu"
+	FieldWorkloadOverrideMemory           = "memory"
+	FieldWorkloadOverrideMin              = "min"
+	FieldWorkloadOverrideMax              = "max"
+	FieldWorkloadOverrideMinReplicas      = "min_replicas"
+	FieldWorkloadOverrideMaxReplicas      = "max_replicas"
+)
+
+// workloadOverrideConfigMask lists the workload configuration fields owned by castai_workload_override. All of them are
+// sent on every patch, so removing an attribute resets the field to the scaling policy's value.
+var workloadOverrideConfigMask = []string{
+	"workloadConfig.vpaConfig.managementOption",
+	"workloadConfig.vpaConfig.cpu.min",
+	"workloadConfig.vpaConfig.cpu.max",
+	"workloadConfig.vpaConfig.memory.min",
+	"workloadConfig.vpaConfig.memory.max",
+	"workloadConfig.hpaConfig.managementOption",
+	"workloadConfig.hpaConfig.minReplicas",
+	"workloadConfig.hpaConfig.maxReplicas",
+}
+
+func resourceWorkloadOverride() *schema.Resource {
+	managementOption := &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Overrides the management option of the scaling policy. `READ_ONLY` disables the optimization, `MANAGED` enables it.",
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+			string(sdk.WorkloadoptimizationV1ManagementOptionMANAGED),
+			string(sdk.WorkloadoptimizationV1ManagementOptionREADONLY),
+		}, false)),
+	}
+	resourceLimits := func(resource, unit string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: fmt.Sprintf("Overrides the bounds of %s recommendations.", resource),
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					FieldWorkloadOverrideMin: {
+						Type:             schema.TypeFloat,
+						Optional:         true,
+						ValidateDiagFunc: validation.ToDiagFunc(validateFloatPositive),
+						Description:      fmt.Sprintf("Minimum %s request recommended, in %s. Must be greater than 0, omit to leave it unbounded.", resource, unit),
+					},
+					FieldWorkloadOverrideMax: {
+						Type:             schema.TypeFloat,
+						Optional:         true,
+						ValidateDiagFunc: validation.ToDiagFunc(validateFloatPositive),
+						Description:      fmt.Sprintf("Maximum %s request recommended, in %s. Must be greater than 0, omit to leave it unbounded.", resource, unit),
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceWorkloadOverrideCreate,
+		ReadContext:   resourceWorkloadOverrideRead,
+		UpdateContext: resourceWorkloadOverrideUpdate,
+		DeleteContext: resourceWorkloadOverrideDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: workloadOverrideImporter,
+		},
+		Description: "Manages the configuration of a single workload, overriding its scaling policy. " +
+			"Destroying the resource resets the workload to its scaling policy's configuration.",
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Read:   schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			FieldClusterID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "CAST AI cluster ID.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsUUID),
+			},
+			FieldWorkloadOverrideNamespace: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Namespace of the workload.",
+			},
+			FieldWorkloadOverrideKind: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Kind of the workload, e.g. `Deployment`.",
+			},
+			FieldWorkloadOverrideName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the workload.",
+			},
+			FieldWorkloadOverrideWorkloadID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "CAST AI ID of the workload.",
+			},
+			FieldWorkloadOverrideScalingPolicyID: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsUUID),
+				Description:      "Assigns the workload to this scaling policy instead of the one selected by assignment rules.",
+			},
+			FieldWorkloadOverrideVertical: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Vertical autoscaling overrides.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						FieldWorkloadOverrideManagementOption: managementOption,
+						FieldWorkloadOverrideCpu:              resourceLimits("CPU", "cores"),
+						FieldWorkloadOverrideMemory:           resourceLimits("memory", "MiB"),
+					},
+				},
+			},
+			FieldWorkloadOverrideHorizontal: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Horizontal autoscaling overrides.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						FieldWorkloadOverrideManagementOption: managementOption,
+						FieldWorkloadOverrideMinReplicas: {
+							Type:             schema.TypeInt,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+							Description:      "Minimum number of replicas.",
+						},
+						FieldWorkloadOverrideMaxReplicas: {
+							Type:             schema.TypeInt,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+							Description:      "Maximum number of replicas.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceWorkloadOverrideCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+	clusterID := d.Get(FieldClusterID).(string)
+
+	workload, err := findWorkload(ctx, client, clusterID,
+		d.Get(FieldWorkloadOverrideNamespace).(string), d.Get(FieldWorkloadOverrideKind).(string), d.Get(FieldWorkloadOverrideName).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(FieldWorkloadOverrideWorkloadID, workload.Id); err != nil {
+		return diag.FromErr(fmt.Errorf("setting workload_id: %w", err))
+	}
+
+	if err := patchWorkloadOverride(ctx, client, clusterID, workload.Id, expandWorkloadOverride(d), d.Get(FieldWorkloadOverrideScalingPolicyID) != ""); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(workloadOverrideID(d))
+	return resourceWorkloadOverrideRead(ctx, d, meta)
+}
+
+func resourceWorkloadOverrideRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+	clusterID := d.Get(FieldClusterID).(string)
+	workloadID := d.Get(FieldWorkloadOverrideWorkloadID).(string)
+
+	resp, err := client.WorkloadOptimizationAPIGetWorkloadWithResponse(ctx, clusterID, workloadID, &sdk.WorkloadOptimizationAPIGetWorkloadParams{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !d.IsNewResource() && resp.StatusCode() == http.StatusNotFound {
+		tflog.Warn(ctx, "Workload not found, removing override from state", map[string]any{"id": d.Id(), "workload_id": workloadID})
+		d.SetId("")
+		return nil
+	}
+	if err := sdk.CheckOKResponse(resp, err); err != nil {
+		return diag.FromErr(fmt.Errorf("getting workload: %w", err))
+	}
+
+	workload := resp.JSON200.Workload
+	// The policy is only tracked when it's forced by the resource, otherwise it's selected by assignment rules.
+	if d.Get(FieldWorkloadOverrideScalingPolicyID) != "" {
+		if err := d.Set(FieldWorkloadOverrideScalingPolicyID, workload.ScalingPolicyId); err != nil {
+			return diag.FromErr(fmt.Errorf("setting scaling_policy_id: %w", err))
+		}
+	}
+
+	overrides := lo.FromPtr(workload.WorkloadOverrides)
+	if err := d.Set(FieldWorkloadOverrideVertical, flattenWorkloadVerticalOverrides(overrides.Vertical)); err != nil {
+		return diag.FromErr(fmt.Errorf("setting vertical: %w", err))
+	}
+	if err := d.Set(FieldWorkloadOverrideHorizontal, flattenWorkloadHorizontalOverrides(overrides.Horizontal)); err != nil {
+		return diag.FromErr(fmt.Errorf("setting horizontal: %w", err))
+	}
+
+	return nil
+}
+
+func resourceWorkloadOverrideUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+	clusterID := d.Get(FieldClusterID).(string)
+	workloadID := d.Get(FieldWorkloadOverrideWorkloadID).(string)
+
+	// A removed scaling_policy_id is sent as null, which returns the workload to its assignment rules.
+	withPolicy := d.Get(FieldWorkloadOverrideScalingPolicyID) != "" || d.HasChange(FieldWorkloadOverrideScalingPolicyID)
+	if err := patchWorkloadOverride(ctx, client, clusterID, workloadID, expandWorkloadOverride(d), withPolicy); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceWorkloadOverrideRead(ctx, d, meta)
+}
+
+func resourceWorkloadOverrideDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*ProviderConfig).api
+	clusterID := d.Get(FieldClusterID).(string)
+	workloadID := d.Get(FieldWorkloadOverrideWorkloadID).(string)
+
+	reset := sdk.WorkloadoptimizationV1PatchWorkloadV2{
+		WorkloadConfig: expandWorkloadConfig(nil, nil),
+	}
+	if err := patchWorkloadOverride(ctx, client, clusterID, workloadID, reset, d.Get(FieldWorkloadOverrideScalingPolicyID) != ""); err != nil {
+		if sdk.IsNotFound(err) {
+			tflog.Debug(ctx, "workload already deleted", map[string]any{"id": d.Id(), "workload_id": workloadID})
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.WorkloadOptimizationAPIResetSystemOverridesWithResponse(ctx, clusterID, workloadID, sdk.WorkloadOptimizationAPIResetSystemOverridesJSONRequestBody{
+		Target: sdk.VERTICALOPTIMIZATION,
+	})
+	if err := sdk.CheckOKResponse(resp, err); err != nil {
+		return diag.FromErr(fmt.Errorf("resetting system overrides: %w", err))
+	}
+
+	return nil
+}
+
+func workloadOverrideImporter(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("expected import id with format: <cluster_id>/<namespace>/<kind>/<name>, got: %q", d.Id())
+	}
+
+	client := meta.(*ProviderConfig).api
+	workload, err := findWorkload(ctx, client, parts[0], parts[1], parts[2], parts[3])
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range map[string]string{
+		FieldClusterID:                  parts[0],
+		FieldWorkloadOverrideNamespace:  parts[1],
+		FieldWorkloadOverrideKind:       parts[2],
+		FieldWorkloadOverrideName:       parts[3],
+		FieldWorkloadOverrideWorkloadID: workload.Id,
+	} {
+		if err := d.Set(key, value); err != nil {
+			return nil, fmt.Errorf("setting %s: %w", key, err)
+		}
+	}
+	// Track the policy only when it was assigned to the workload directly rather than by assignment rules.
+	if workload.ScalingPolicyOrigin == sdk.ORIGINAPI {
+		if err := d.Set(FieldWorkloadOverrideScalingPolicyID, workload.ScalingPolicyId); err != nil {
+			return nil, fmt.Errorf("setting scaling_policy_id: %w", err)
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func workloadOverrideID(d *schema.ResourceData) string {
+	return strings.Join([]string{
+		d.Get(FieldClusterID).(string),
+		d.Get(FieldWorkloadOverrideNamespace).(string),
+		d.Get(FieldWorkloadOverrideKind).(string),
+		d.Get(FieldWorkloadOverrideName).(string),
+	}, "/")
+}
+
+// findWorkload returns the workload of the cluster with the given namespace, kind and name.
+func findWorkload(ctx context.Context, client sdk.ClientWithResponsesInterface, clusterID, namespace, kind, name string) (*sdk.WorkloadoptimizationV1Workload, error) {
+	params := &sdk.WorkloadOptimizationAPIListWorkloadsParams{
+		Namespaces:    &[]string{namespace},
+		Kinds:         &[]string{kind},
+		WorkloadNames: &[]string{name},
+	}
+	for {
+		resp, err := client.WorkloadOptimizationAPIListWorkloadsWithResponse(ctx, clusterID, params)
+		if err := sdk.CheckOKResponse(resp, err); err != nil {
+			return nil, fmt.Errorf("listing workloads: %w", err)
+		}
+
+		for _, workload := range resp.JSON200.Workloads {
+			if workload.Namespace == namespace && workload.Kind == kind && workload.Name == name {
+				return &workload, nil
+			}
+		}
+
+		if lo.FromPtr(resp.JSON200.NextCursor) == "" {
+			return nil, fmt.Errorf("workload %s/%s/%s was not found in cluster %s", namespace, kind, name, clusterID)
+		}
+		params.PageCursor = resp.JSON200.NextCursor
+	}
+}
+
+func patchWorkloadOverride(
+	ctx context.Context,
+	client sdk.ClientWithResponsesInterface,
+	clusterID, workloadID string,
+	workload sdk.WorkloadoptimizationV1PatchWorkloadV2,
+	withPolicy bool,
+) error {
+	mask := workloadOverrideConfigMask
+	if withPolicy {
+		mask = append([]string{"scalingPolicyId"}, mask...)
+	}
+
+	resp, err := client.WorkloadOptimizationAPIPatchWorkloadV2WithResponse(ctx, clusterID, workloadID, sdk.WorkloadOptimizationAPIPatchWorkloadV2JSONRequestBody{
+		UpdateMask: lo.ToPtr(strings.Join(mask, ",")),
+		Workload:   &workload,
+	})
+	if err := sdk.CheckOKResponse(resp, err); err != nil {
+		return fmt.Errorf("patching workload: %w", err)
+	}
+	return nil
+}
+
+func expandWorkloadOverride(d *schema.ResourceData) sdk.WorkloadoptimizationV1PatchWorkloadV2 {
+	return sdk.WorkloadoptimizationV1PatchWorkloadV2{
+		ScalingPolicyId: lo.EmptyableToPtr(d.Get(FieldWorkloadOverrideScalingPolicyID).(string)),
+		WorkloadConfig:  expandWorkloadConfig(toSection(d, FieldWorkloadOverrideVertical), toSection(d, FieldWorkloadOverrideHorizontal)),
+	}
+}
+
+// validateFloatPositive rejects zero bounds, which expandWorkloadConfig sends as unset.
+func validateFloatPositive(v any, k string) ([]string, []error) {
+	value, ok := v.(float64)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be float", k)}
+	}
+	if value <= 0 {
+		return nil, []error{fmt.Errorf("expected %s to be greater than 0, got %f", k, value)}
+	}
+	return nil, nil
+}
+
+// expandWorkloadConfig returns the workload configuration with every masked field present, unset ones as null.
+func expandWorkloadConfig(vertical, horizontal map[string]any) *sdk.WorkloadoptimizationV1WorkloadConfigUpdateV2 {
+	resourceConfig := func(section string) *sdk.WorkloadoptimizationV1WorkloadResourceConfigUpdate {
+		limits := firstSection(vertical[section])
+		minValue, _ := limits[FieldWorkloadOverrideMin].(float64)
+		maxValue, _ := limits[FieldWorkloadOverrideMax].(float64)
+		return &sdk.WorkloadoptimizationV1WorkloadResourceConfigUpdate{
+			Min: lo.EmptyableToPtr(minValue),
+			Max: lo.EmptyableToPtr(maxValue),
+		}
+	}
+	managementOption := func(section map[string]any) *sdk.WorkloadoptimizationV1ManagementOption {
+		option, _ := section[FieldWorkloadOverrideManagementOption].(string)
+		return lo.EmptyableToPtr(sdk.WorkloadoptimizationV1ManagementOption(option))
+	}
+	replicas := func(key string) *int32 {
+		value, _ := horizontal[key].(int)
+		return lo.EmptyableToPtr(int32(value))
+	}
+
+	return &sdk.WorkloadoptimizationV1WorkloadConfigUpdateV2{
+		VpaConfig: &sdk.WorkloadoptimizationV1VPAConfigUpdate{
+			ManagementOption: managementOption(vertical),
+			Cpu:              resourceConfig(FieldWorkloadOverrideCpu),
+			Memory:           resourceConfig(FieldWorkloadOverrideMemory),
+		},
+		HpaConfig: &sdk.WorkloadoptimizationV1HPAConfigUpdate{
+			ManagementOption: managementOption(horizontal),
+			MinReplicas:      replicas(FieldWorkloadOverrideMinReplicas),
+			MaxReplicas:      replicas(FieldWorkloadOverrideMaxReplicas),
+		},
+	}
+}
+
+func flattenWorkloadVerticalOverrides(vertical *sdk.WorkloadoptimizationV1VerticalOverrides) []map[string]any {
+	if vertical == nil {
+		return nil
+	}
+
+	resourceLimits := func(config *sdk.WorkloadoptimizationV1ResourceConfigOverrides) []map[string]any {
+		if config == nil || (config.Min == nil && config.Max == nil) {
+			return nil
+		}
+		return []map[string]any{{
+			FieldWorkloadOverrideMin: lo.FromPtr(config.Min),
+			FieldWorkloadOverrideMax: lo.FromPtr(config.Max),
+		}}
+	}
+	out := map[string]any{
+		FieldWorkloadOverrideManagementOption: string(lo.FromPtr(vertical.ManagementOption)),
+		FieldWorkloadOverrideCpu:              resourceLimits(vertical.Cpu),
+		FieldWorkloadOverrideMemory:           resourceLimits(vertical.Memory),
+	}
+	// Overrides of settings the resource doesn't manage don't make the block appear.
+	if vertical.ManagementOption == nil && out[FieldWorkloadOverrideCpu] == nil && out[FieldWorkloadOverrideMemory] == nil {
+		return nil
+	}
+	return []map[string]any{out}
+}
+
+func flattenWorkloadHorizontalOverrides(horizontal *sdk.WorkloadoptimizationV1HorizontalOverrides) []map[string]any {
+	if horizontal == nil || (horizontal.ManagementOption == nil && horizontal.MinReplicas == nil && horizontal.MaxReplicas == nil) {
+		return nil
+	}
+
+	return []map[string]any{{
+		FieldWorkloadOverrideManagementOption: string(lo.FromPtr(horizontal.ManagementOption)),
+		FieldWorkloadOverrideMinReplicas:      int(lo.FromPtr(horizontal.MinReplicas)),
+		FieldWorkloadOverrideMaxReplicas:      int(lo.FromPtr(horizontal.MaxReplicas)),
+	}}
+}
+
+// firstSection returns the only element of a block with MaxItems 1, or nil when the block isn't set.
+func firstSection(value any) map[string]any {
+	sections, _ := value.([]any)
+	if len(sections) == 0 || sections[0] == nil {
+		return nil
+	}
+	return sections[0].(map[string]any)
+}
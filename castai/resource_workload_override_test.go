@@ -0,0 +1,223 @@
+package castai
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/castai/terraform-provider-castai/castai/sdk"
+	mock_sdk "github.com/castai/terraform-provider-castai/castai/sdk/mock"
+)
+
+func TestWorkloadOverrideResource(t *testing.T) {
+	t.Parallel()
+
+	clusterID := "b6bfc074-a267-400f-b8f1-db0850c36af1"
+	policyID := "b6bfc074-a267-400f-b8f1-db0850c36af2"
+	workloadID := "b6bfc074-a267-400f-b8f1-db0850c36af3"
+	newResponse := func(status int, body string) *http.Response {
+		return &http.Response{StatusCode: status, Body: io.NopCloser(bytes.NewReader([]byte(body))), Header: map[string][]string{"Content-Type": {"json"}}}
+	}
+	listParams := &sdk.WorkloadOptimizationAPIListWorkloadsParams{
+		Namespaces:    lo.ToPtr([]string{"team-a"}),
+		Kinds:         lo.ToPtr([]string{"Deployment"}),
+		WorkloadNames: lo.ToPtr([]string{"api"}),
+	}
+	listResponse := `{"workloads": [
+  {"id": "other", "name": "api", "namespace": "team-a", "kind": "StatefulSet"},
+  {"id": "` + workloadID + `", "name": "api", "namespace": "team-a", "kind": "Deployment", "scalingPolicyId": "` + policyID + `", "scalingPolicyOrigin": "ORIGIN_API"}
+]}`
+	getResponse := `{"workload": {
+  "id": "` + workloadID + `",
+  "scalingPolicyId": "` + policyID + `",
+  "workloadOverrides": {
+    "vertical": {"managementOption": "READ_ONLY", "cpu": {"max": 2}, "applyType": "IMMEDIATE"},
+    "horizontal": {"minReplicas": 2, "maxReplicas": 5}
+  }
+}}`
+
+	t.Run("should patch the workload with every managed field on create", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		gomock.InOrder(
+			mockClient.EXPECT().WorkloadOptimizationAPIListWorkloads(gomock.Any(), clusterID, listParams).
+				Return(newResponse(http.StatusOK, listResponse), nil),
+			mockClient.EXPECT().WorkloadOptimizationAPIPatchWorkloadV2(gomock.Any(), clusterID, workloadID, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _, _ string, body sdk.WorkloadOptimizationAPIPatchWorkloadV2JSONRequestBody, _ ...sdk.RequestEditorFn) (*http.Response, error) {
+					r.Equal("scalingPolicyId,"+
+						"workloadConfig.vpaConfig.managementOption,workloadConfig.vpaConfig.cpu.min,workloadConfig.vpaConfig.cpu.max,"+
+						"workloadConfig.vpaConfig.memory.min,workloadConfig.vpaConfig.memory.max,"+
+						"workloadConfig.hpaConfig.managementOption,workloadConfig.hpaConfig.minReplicas,workloadConfig.hpaConfig.maxReplicas",
+						lo.FromPtr(body.UpdateMask))
+					r.Equal(policyID, lo.FromPtr(body.Workload.ScalingPolicyId))
+
+					vpa := body.Workload.WorkloadConfig.VpaConfig
+					r.Equal(sdk.WorkloadoptimizationV1ManagementOptionREADONLY, lo.FromPtr(vpa.ManagementOption))
+					r.Nil(vpa.Cpu.Min)
+					r.Equal(2.0, lo.FromPtr(vpa.Cpu.Max))
+					r.NotNil(vpa.Memory)
+					r.Nil(vpa.Memory.Max)
+
+					hpa := body.Workload.WorkloadConfig.HpaConfig
+					r.Nil(hpa.ManagementOption)
+					r.Equal(int32(2), lo.FromPtr(hpa.MinReplicas))
+					r.Equal(int32(5), lo.FromPtr(hpa.MaxReplicas))
+					return newResponse(http.StatusOK, `{}`), nil
+				}),
+			mockClient.EXPECT().WorkloadOptimizationAPIGetWorkload(gomock.Any(), clusterID, workloadID, gomock.Any()).
+				Return(newResponse(http.StatusOK, getResponse), nil),
+		)
+
+		data := schema.TestResourceDataRaw(t, resourceWorkloadOverride().Schema, map[string]any{
+			FieldClusterID:                       clusterID,
+			FieldWorkloadOverrideNamespace:       "team-a",
+			FieldWorkloadOverrideKind:            "Deployment",
+			FieldWorkloadOverrideName:            "api",
+			FieldWorkloadOverrideScalingPolicyID: policyID,
+			FieldWorkloadOverrideVertical: []any{map[string]any{
+				FieldWorkloadOverrideManagementOption: "READ_ONLY",
+				FieldWorkloadOverrideCpu:              []any{map[string]any{FieldWorkloadOverrideMax: 2.0}},
+			}},
+			FieldWorkloadOverrideHorizontal: []any{map[string]any{
+				FieldWorkloadOverrideMinReplicas: 2,
+				FieldWorkloadOverrideMaxReplicas: 5,
+			}},
+		})
+
+		diags := resourceWorkloadOverrideCreate(context.Background(), data, provider)
+		r.Nil(diags)
+		r.Equal(clusterID+"/team-a/Deployment/api", data.Id())
+		r.Equal(workloadID, data.Get(FieldWorkloadOverrideWorkloadID))
+		r.Equal(policyID, data.Get(FieldWorkloadOverrideScalingPolicyID))
+		r.Equal("READ_ONLY", data.Get(FieldWorkloadOverrideVertical+".0."+FieldWorkloadOverrideManagementOption))
+		r.Equal(2.0, data.Get(FieldWorkloadOverrideVertical+".0."+FieldWorkloadOverrideCpu+".0."+FieldWorkloadOverrideMax))
+		r.Equal(0, data.Get(FieldWorkloadOverrideVertical+".0."+FieldWorkloadOverrideMemory+".#"))
+		r.Equal(5, data.Get(FieldWorkloadOverrideHorizontal+".0."+FieldWorkloadOverrideMaxReplicas))
+	})
+
+	t.Run("should fail create when workload doesn't exist", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		mockClient.EXPECT().WorkloadOptimizationAPIListWorkloads(gomock.Any(), clusterID, listParams).
+			Return(newResponse(http.StatusOK, `{"workloads": []}`), nil)
+
+		data := schema.TestResourceDataRaw(t, resourceWorkloadOverride().Schema, map[string]any{
+			FieldClusterID:                 clusterID,
+			FieldWorkloadOverrideNamespace: "team-a",
+			FieldWorkloadOverrideKind:      "Deployment",
+			FieldWorkloadOverrideName:      "api",
+		})
+
+		diags := resourceWorkloadOverrideCreate(context.Background(), data, provider)
+		r.True(diags.HasError())
+		r.Contains(diags[0].Summary, "workload team-a/Deployment/api was not found")
+	})
+
+	t.Run("should remove override from state when workload is gone", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		mockClient.EXPECT().WorkloadOptimizationAPIGetWorkload(gomock.Any(), clusterID, workloadID, gomock.Any()).
+			Return(newResponse(http.StatusNotFound, `{}`), nil)
+
+		data := schema.TestResourceDataRaw(t, resourceWorkloadOverride().Schema, map[string]any{
+			FieldClusterID:                  clusterID,
+			FieldWorkloadOverrideWorkloadID: workloadID,
+		})
+		data.SetId(clusterID + "/team-a/Deployment/api")
+
+		diags := resourceWorkloadOverrideRead(context.Background(), data, provider)
+		r.Nil(diags)
+		r.Empty(data.Id())
+	})
+
+	t.Run("should clear overrides and release the policy on delete", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		gomock.InOrder(
+			mockClient.EXPECT().WorkloadOptimizationAPIPatchWorkloadV2(gomock.Any(), clusterID, workloadID, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _, _ string, body sdk.WorkloadOptimizationAPIPatchWorkloadV2JSONRequestBody, _ ...sdk.RequestEditorFn) (*http.Response, error) {
+					r.Contains(lo.FromPtr(body.UpdateMask), "scalingPolicyId,")
+					r.Nil(body.Workload.ScalingPolicyId)
+					r.Nil(body.Workload.WorkloadConfig.VpaConfig.ManagementOption)
+					r.Nil(body.Workload.WorkloadConfig.VpaConfig.Cpu.Max)
+					r.Nil(body.Workload.WorkloadConfig.HpaConfig.MinReplicas)
+					return newResponse(http.StatusOK, `{}`), nil
+				}),
+			mockClient.EXPECT().WorkloadOptimizationAPIResetSystemOverrides(gomock.Any(), clusterID, workloadID, sdk.WorkloadOptimizationAPIResetSystemOverridesJSONRequestBody{
+				Target: sdk.VERTICALOPTIMIZATION,
+			}).Return(newResponse(http.StatusOK, `{}`), nil),
+		)
+
+		data := schema.TestResourceDataRaw(t, resourceWorkloadOverride().Schema, map[string]any{
+			FieldClusterID:                       clusterID,
+			FieldWorkloadOverrideWorkloadID:      workloadID,
+			FieldWorkloadOverrideScalingPolicyID: policyID,
+		})
+		data.SetId(clusterID + "/team-a/Deployment/api")
+
+		diags := resourceWorkloadOverrideDelete(context.Background(), data, provider)
+		r.Nil(diags)
+	})
+
+	t.Run("should import by cluster, namespace, kind and name", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+		mockClient := mock_sdk.NewMockClientInterface(gomock.NewController(t))
+		provider := &ProviderConfig{api: &sdk.ClientWithResponses{ClientInterface: mockClient}}
+
+		mockClient.EXPECT().WorkloadOptimizationAPIListWorkloads(gomock.Any(), clusterID, listParams).
+			Return(newResponse(http.StatusOK, listResponse), nil)
+
+		data := schema.TestResourceDataRaw(t, resourceWorkloadOverride().Schema, map[string]any{})
+		data.SetId(clusterID + "/team-a/Deployment/api")
+
+		result, err := workloadOverrideImporter(context.Background(), data, provider)
+		r.NoError(err)
+		r.Len(result, 1)
+		r.Equal("team-a", result[0].Get(FieldWorkloadOverrideNamespace))
+		r.Equal("Deployment", result[0].Get(FieldWorkloadOverrideKind))
+		r.Equal(workloadID, result[0].Get(FieldWorkloadOverrideWorkloadID))
+		r.Equal(policyID, result[0].Get(FieldWorkloadOverrideScalingPolicyID))
+
+		data.SetId(clusterID + "/team-a/api")
+		_, err = workloadOverrideImporter(context.Background(), data, provider)
+		r.ErrorContains(err, "expected import id with format")
+	})
+
+	t.Run("should reject zero recommendation bounds", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+
+		diags := resourceWorkloadOverride().Validate(terraform.NewResourceConfigRaw(map[string]any{
+			FieldClusterID:                 clusterID,
+			FieldWorkloadOverrideNamespace: "team-a",
+			FieldWorkloadOverrideKind:      "Deployment",
+			FieldWorkloadOverrideName:      "api",
+			FieldWorkloadOverrideVertical: []any{map[string]any{
+				FieldWorkloadOverrideCpu: []any{map[string]any{FieldWorkloadOverrideMin: 0}},
+			}},
+		}))
+		r.True(diags.HasError())
+		r.Contains(diags[0].Summary, "expected min to be greater than 0")
+	})
+}
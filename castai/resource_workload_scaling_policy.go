@@ -842,9 +842,7 @@ func resourceWorkloadScalingPolicyCreate(ctx context.Context, d *schema.Resource
 	}
 	req.AssignmentRules = ar
 
-	b := backoff.WithContext(backoff.NewExponentialBackOff(), ctx)
-	var lastErr error
-	if err := backoff.RetryNotify(func() error {
+	createPolicy := func(ctx context.Context) error {
 		create, createErr := client.WorkloadOptimizationAPICreateWorkloadScalingPolicyWithResponse(ctx, clusterID, req)
 		if createErr != nil {
 			return createErr
@@ -868,26 +866,26 @@ func resourceWorkloadScalingPolicyCreate(ctx context.Context, d *schema.Resource
 		default:
 			return checkIfRetryable(create, createErr)
 		}
-	}, b, func(err error, _ time.Duration) {
-		if !isInterrupt(err) {
-			tflog.Warn(ctx, "Error creating workload scaling policy", map[string]any{
-				"error": err,
-			})
-			lastErr = err
+	}
+	if err := waitFor(ctx, fmt.Sprintf("workload scaling policy %q creation", req.Name), d.Timeout(schema.TimeoutCreate), func(ctx context.Context) (bool, string, error) {
+		err := createPolicy(ctx)
+		if err == nil {
+			return true, "created", nil
 		}
-	}); err != nil {
-		if isInterrupt(err) {
-			return diag.FromErr(lastErr)
+		var permanent *backoff.PermanentError
+		if errors.As(err, &permanent) {
+			return false, "", permanent.Err
 		}
+		tflog.Warn(ctx, "Error creating workload scaling policy", map[string]any{
+			"error": err,
+		})
+		return false, err.Error(), nil
+	}); err != nil {
 		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func isInterrupt(err error) bool {
-	return errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled)
-}
-
 func checkIfRetryable(response sdk.Response, err error) error {
 	if err != nil {
 		return err
@@ -4,8 +4,8 @@ import (
 	"github.com/castai/terraform-provider-castai/castai/sdk/client"
 )
 
-func CreateClient(apiURL, apiToken, userAgent string) (*ClientWithResponses, error) {
-	httpClient, editors := client.GetHttpClient(apiToken, userAgent)
+func CreateClient(apiURL, apiToken, userAgent string, opts ...client.Option) (*ClientWithResponses, error) {
+	httpClient, editors := client.GetHttpClient(apiToken, userAgent, opts...)
 	httpClientOption := func(c *Client) error {
 		c.Client = httpClient
 
@@ -15,12 +15,16 @@ const (
 	ClusterStatusArchived = "archived"
 	ClusterStatusFailed   = "failed"
 
+	ClusterStatusHibernating = "hibernating"
+	ClusterStatusHibernated  = "hibernated"
+	ClusterStatusResuming    = "resuming"
+
 	ClusterAgentStatusDisconnected  = "disconnected"
 	ClusterAgentStatusDisconnecting = "disconnecting"
 )
 
-func CreateClient(apiURL, apiToken, userAgent string) (*ClientWithResponses, error) {
-	httpClient, editors := client.GetHttpClient(apiToken, userAgent)
+func CreateClient(apiURL, apiToken, userAgent string, opts ...client.Option) (*ClientWithResponses, error) {
+	httpClient, editors := client.GetHttpClient(apiToken, userAgent, opts...)
 	httpClientOption := func(client *Client) error {
 		client.Client = httpClient
 
@@ -8,10 +8,57 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 )
 
-func GetHttpClient(apiToken, userAgent string) (*http.Client, []func(ctx context.Context, req *http.Request) error) {
+const (
+	DefaultMaxRetries     = 3
+	DefaultRetryMaxWait   = 30 * time.Second
+	DefaultRequestTimeout = 1 * time.Minute
+)
+
+type config struct {
+	maxRetries     int
+	retryMaxWait   time.Duration
+	requestTimeout time.Duration
+}
+
+// Option configures the HTTP client returned by GetHttpClient.
+type Option func(*config)
+
+// WithMaxRetries sets how many times a failed request is retried. Zero disables retries.
+func WithMaxRetries(maxRetries int) Option {
+	return func(c *config) {
+		c.maxRetries = maxRetries
+	}
+}
+
+// WithRetryMaxWait caps the delay between two attempts, including delays requested via Retry-After.
+func WithRetryMaxWait(wait time.Duration) Option {
+	return func(c *config) {
+		c.retryMaxWait = wait
+	}
+}
+
+// WithRequestTimeout sets the timeout of a single attempt.
+func WithRequestTimeout(timeout time.Duration) Option {
+	return func(c *config) {
+		c.requestTimeout = timeout
+	}
+}
+
+func GetHttpClient(apiToken, userAgent string, opts ...Option) (*http.Client, []func(ctx context.Context, req *http.Request) error) {
+	cfg := config{
+		maxRetries:     DefaultMaxRetries,
+		retryMaxWait:   DefaultRetryMaxWait,
+		requestTimeout: DefaultRequestTimeout,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
 	client := &http.Client{
-		Transport: logging.NewSubsystemLoggingHTTPTransport("CAST.AI", http.DefaultTransport),
-		Timeout:   1 * time.Minute,
+		Transport: newRetryTransport(
+			logging.NewSubsystemLoggingHTTPTransport("CAST.AI", http.DefaultTransport),
+			cfg,
+		),
 	}
 	requestEditors := []func(ctx context.Context, req *http.Request) error{
 		func(_ context.Context, req *http.Request) error {
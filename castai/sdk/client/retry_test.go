@@ -0,0 +1,151 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// scriptedServer replies with the given status codes in order and with 200 once the script is exhausted.
+func scriptedServer(t *testing.T, statuses []int, headers map[string]string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		call := int(calls.Add(1)) - 1
+		if call < len(statuses) {
+			for k, v := range headers {
+				w.Header().Set(k, v)
+			}
+			w.WriteHeader(statuses[call])
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &calls
+}
+
+func testClient(opts ...Option) *http.Client {
+	client, _ := GetHttpClient("token", "test", append([]Option{WithRetryMaxWait(10 * time.Millisecond)}, opts...)...)
+	return client
+}
+
+func TestRetryTransport(t *testing.T) {
+	t.Run("retries 429 and 503 until success", func(t *testing.T) {
+		r := require.New(t)
+		srv, calls := scriptedServer(t, []int{http.StatusTooManyRequests, http.StatusServiceUnavailable}, nil)
+
+		resp, err := testClient().Get(srv.URL)
+		r.NoError(err)
+		defer resp.Body.Close()
+
+		r.Equal(http.StatusOK, resp.StatusCode)
+		r.EqualValues(3, calls.Load())
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		r := require.New(t)
+		srv, calls := scriptedServer(t, []int{503, 503, 503, 503, 503}, nil)
+
+		resp, err := testClient(WithMaxRetries(2)).Get(srv.URL)
+		r.NoError(err)
+		defer resp.Body.Close()
+
+		r.Equal(http.StatusServiceUnavailable, resp.StatusCode)
+		r.EqualValues(3, calls.Load())
+	})
+
+	t.Run("retries 429 for non idempotent request and resends body", func(t *testing.T) {
+		r := require.New(t)
+		srv, calls := scriptedServer(t, []int{http.StatusTooManyRequests}, nil)
+
+		resp, err := testClient().Post(srv.URL, "application/json", strings.NewReader(`{"name":"test"}`))
+		r.NoError(err)
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		r.NoError(err)
+		r.Equal(http.StatusOK, resp.StatusCode)
+		r.Equal(`{"name":"test"}`, string(body))
+		r.EqualValues(2, calls.Load())
+	})
+
+	t.Run("does not retry 503 for non idempotent request", func(t *testing.T) {
+		r := require.New(t)
+		srv, calls := scriptedServer(t, []int{http.StatusServiceUnavailable}, nil)
+
+		resp, err := testClient().Post(srv.URL, "application/json", strings.NewReader(`{}`))
+		r.NoError(err)
+		defer resp.Body.Close()
+
+		r.Equal(http.StatusServiceUnavailable, resp.StatusCode)
+		r.EqualValues(1, calls.Load())
+	})
+
+	t.Run("does not retry client errors", func(t *testing.T) {
+		r := require.New(t)
+		srv, calls := scriptedServer(t, []int{http.StatusBadRequest}, nil)
+
+		resp, err := testClient().Get(srv.URL)
+		r.NoError(err)
+		defer resp.Body.Close()
+
+		r.Equal(http.StatusBadRequest, resp.StatusCode)
+		r.EqualValues(1, calls.Load())
+	})
+
+	t.Run("honors Retry-After capped by max wait", func(t *testing.T) {
+		r := require.New(t)
+		srv, calls := scriptedServer(t, []int{http.StatusTooManyRequests}, map[string]string{"Retry-After": "1"})
+
+		start := time.Now()
+		resp, err := testClient(WithRetryMaxWait(200 * time.Millisecond)).Get(srv.URL)
+		r.NoError(err)
+		defer resp.Body.Close()
+
+		r.Equal(http.StatusOK, resp.StatusCode)
+		r.EqualValues(2, calls.Load())
+		r.GreaterOrEqual(time.Since(start), 200*time.Millisecond)
+		r.Less(time.Since(start), time.Second)
+	})
+
+	t.Run("zero max retries disables retrying", func(t *testing.T) {
+		r := require.New(t)
+		srv, calls := scriptedServer(t, []int{http.StatusTooManyRequests}, nil)
+
+		resp, err := testClient(WithMaxRetries(0)).Get(srv.URL)
+		r.NoError(err)
+		defer resp.Body.Close()
+
+		r.Equal(http.StatusTooManyRequests, resp.StatusCode)
+		r.EqualValues(1, calls.Load())
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	r := require.New(t)
+
+	d, ok := parseRetryAfter("5")
+	r.True(ok)
+	r.Equal(5*time.Second, d)
+
+	d, ok = parseRetryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	r.True(ok)
+	r.Equal(time.Duration(0), d)
+
+	_, ok = parseRetryAfter("")
+	r.False(ok)
+
+	_, ok = parseRetryAfter("soon")
+	r.False(ok)
+}
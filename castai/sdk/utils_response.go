@@ -2,9 +2,11 @@ package sdk
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"reflect"
 )
 
 func CheckGetResponse(response Response, err error) error {
@@ -40,7 +42,11 @@ func checkResponse(response Response, err error, expectedStatus int) error {
 	}
 
 	if response.StatusCode() != expectedStatus {
-		return fmt.Errorf("expected status code %d, received: status=%d body=%s", expectedStatus, response.StatusCode(), string(response.GetBody()))
+		var header http.Header
+		if httpResp := httpResponseOf(response); httpResp != nil {
+			header = httpResp.Header
+		}
+		return newAPIError(expectedStatus, response.StatusCode(), response.GetBody(), header)
 	}
 
 	return nil
@@ -56,17 +62,104 @@ func checkRawResponse(response *http.Response, err error, expectedStatus int) er
 		if err != nil {
 			return fmt.Errorf("reading response body: %w", err)
 		}
-		return fmt.Errorf("expected status code %d, received: status=%d body=%s", expectedStatus, response.StatusCode, body)
+		return newAPIError(expectedStatus, response.StatusCode, body, response.Header)
 	}
 	return nil
 }
 
 type ErrorResponse struct {
-	Message         string `json:"message"`
-	FieldViolations []struct {
-		Field       string `json:"field"`
-		Description string `json:"description"`
-	} `json:"fieldViolations"`
+	Message         string           `json:"message"`
+	FieldViolations []FieldViolation `json:"fieldViolations"`
+}
+
+// FieldViolation describes a single invalid field of a request. Field is a dot separated path in the API
+// representation of the request, e.g. "constraints.instanceFamilies.include".
+type FieldViolation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+// APIError is returned by the Check* helpers when the API responds with an unexpected status code.
+type APIError struct {
+	ExpectedStatus  int
+	StatusCode      int
+	Message         string
+	RequestID       string
+	FieldViolations []FieldViolation
+	Body            []byte
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("expected status code %d, received: status=%d body=%s", e.ExpectedStatus, e.StatusCode, string(e.Body))
+}
+
+func newAPIError(expectedStatus, statusCode int, body []byte, header http.Header) *APIError {
+	apiErr := &APIError{
+		ExpectedStatus: expectedStatus,
+		StatusCode:     statusCode,
+		Body:           body,
+		RequestID:      header.Get("X-Request-Id"),
+	}
+
+	var errResponse ErrorResponse
+	if err := json.Unmarshal(body, &errResponse); err == nil {
+		apiErr.Message = errResponse.Message
+		apiErr.FieldViolations = errResponse.FieldViolations
+	}
+
+	return apiErr
+}
+
+// AsAPIError returns the APIError wrapped in err, if any.
+func AsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return nil, false
+}
+
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+func IsForbidden(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+func IsBadRequest(err error) bool {
+	return hasStatus(err, http.StatusBadRequest)
+}
+
+func hasStatus(err error, status int) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.StatusCode == status
+}
+
+// httpResponseOf returns the raw HTTP response of generated response types, which all embed it in the
+// HTTPResponse field but do not expose it through the Response interface.
+func httpResponseOf(response Response) *http.Response {
+	v := reflect.ValueOf(response)
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	field := v.FieldByName("HTTPResponse")
+	if !field.IsValid() {
+		return nil
+	}
+	httpResp, _ := field.Interface().(*http.Response)
+	return httpResp
 }
 
 func IsCredentialsError(response Response) bool {
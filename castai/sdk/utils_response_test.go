@@ -1,6 +1,9 @@
 package sdk
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -52,3 +55,58 @@ func Test_IsCredentialsError(t *testing.T) {
 		r.False(result)
 	})
 }
+
+func TestCheckResponse_APIError(t *testing.T) {
+	t.Run("returns typed error with field violations", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+
+		body := `{
+	"message": "Bad Request",
+	"fieldViolations":
+	[{"field": "constraints.instanceFamilies.include", "description": "unknown instance family"}]
+}`
+		resp := &NodeTemplatesAPICreateNodeTemplateResponse{
+			Body: []byte(body),
+			HTTPResponse: &http.Response{
+				StatusCode: http.StatusBadRequest,
+				Header:     http.Header{"X-Request-Id": []string{"req-1"}},
+			},
+		}
+
+		err := fmt.Errorf("creating node template: %w", CheckOKResponse(resp, nil))
+		apiErr, ok := AsAPIError(err)
+		r.True(ok)
+		r.Equal(http.StatusOK, apiErr.ExpectedStatus)
+		r.Equal(http.StatusBadRequest, apiErr.StatusCode)
+		r.Equal("Bad Request", apiErr.Message)
+		r.Equal("req-1", apiErr.RequestID)
+		r.Equal([]FieldViolation{{Field: "constraints.instanceFamilies.include", Description: "unknown instance family"}}, apiErr.FieldViolations)
+		r.True(IsBadRequest(err))
+		r.False(IsNotFound(err))
+		r.Contains(err.Error(), "expected status code 200, received: status=400 body=")
+	})
+
+	t.Run("status helpers", func(t *testing.T) {
+		t.Parallel()
+		r := require.New(t)
+
+		check := func(status int) error {
+			return CheckOKResponse(&NodeTemplatesAPICreateNodeTemplateResponse{
+				Body:         []byte("plain text"),
+				HTTPResponse: &http.Response{StatusCode: status},
+			}, nil)
+		}
+
+		r.True(IsNotFound(check(http.StatusNotFound)))
+		r.True(IsConflict(check(http.StatusConflict)))
+		r.True(IsForbidden(check(http.StatusForbidden)))
+		r.False(IsForbidden(errors.New("expected status code 200, received: status=403")))
+		r.NoError(check(http.StatusOK))
+
+		apiErr, ok := AsAPIError(check(http.StatusNotFound))
+		r.True(ok)
+		r.Empty(apiErr.Message)
+		r.Empty(apiErr.FieldViolations)
+	})
+}
@@ -1,6 +1,7 @@
 package castai
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/hashicorp/go-cty/cty"
@@ -26,3 +27,23 @@ func validateRFC3339TimeOrEmpty(i interface{}, path cty.Path) diag.Diagnostics {
 
 	return nil
 }
+
+func validateDuration(i interface{}, path cty.Path) diag.Diagnostics {
+	v, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected type of %v to be string", path)
+	}
+
+	if _, err := time.ParseDuration(v); err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid duration",
+				Detail:        fmt.Sprintf("%q is not a valid duration, expected a value such as 30s or 1m: %v", v, err),
+				AttributePath: path,
+			},
+		}
+	}
+
+	return nil
+}
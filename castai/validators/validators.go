@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
@@ -71,3 +72,33 @@ func (v JSONValidator) ValidateString(ctx context.Context, req validator.StringR
 		)
 	}
 }
+
+var _ validator.String = DurationValidator{}
+
+// DurationValidator validates that a string can be parsed by time.ParseDuration.
+type DurationValidator struct{}
+
+func ValidDuration() DurationValidator {
+	return DurationValidator{}
+}
+
+func (v DurationValidator) Description(_ context.Context) string {
+	return "value must be a valid duration such as 30s or 1m"
+}
+
+func (v DurationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v DurationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := time.ParseDuration(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration Value",
+			fmt.Sprintf("Value must be a valid duration such as 30s or 1m: %s", err.Error()),
+		)
+	}
+}
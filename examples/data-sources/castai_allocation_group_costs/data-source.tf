@@ -0,0 +1,16 @@
+data "castai_allocation_group_costs" "team" {
+  allocation_group_id = castai_allocation_group.team.id
+  window              = "720h"
+  step                = "24h"
+}
+
+output "team_monthly_cost" {
+  value = data.castai_allocation_group_costs.team.total_cost
+}
+
+check "team_within_budget" {
+  assert {
+    condition     = data.castai_allocation_group_costs.team.total_cost <= 5000
+    error_message = "Team ${data.castai_allocation_group_costs.team.group_name} exceeded its monthly budget of $5000."
+  }
+}
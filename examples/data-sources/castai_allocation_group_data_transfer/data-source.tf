@@ -0,0 +1,16 @@
+data "castai_allocation_group_data_transfer" "team" {
+  allocation_group_id = castai_allocation_group.team.id
+  window              = "720h"
+  top_workloads_count = 5
+}
+
+output "team_cross_zone_cost" {
+  value = data.castai_allocation_group_data_transfer.team.cross_zone_cost
+}
+
+output "team_top_talkers" {
+  value = [
+    for w in data.castai_allocation_group_data_transfer.team.top_workloads :
+    "${w.namespace}/${w.workload_name}: $${w.total_cost}"
+  ]
+}
@@ -0,0 +1,11 @@
+data "castai_allocation_group_efficiency" "team" {
+  allocation_group_id = castai_allocation_group.team.id
+  window              = "168h"
+}
+
+check "team_cpu_efficiency" {
+  assert {
+    condition     = data.castai_allocation_group_efficiency.team.cpu_efficiency >= 0.5
+    error_message = "Team ${data.castai_allocation_group_efficiency.team.group_name} uses less than half of requested CPU."
+  }
+}
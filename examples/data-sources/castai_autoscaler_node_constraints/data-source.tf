@@ -0,0 +1,15 @@
+data "castai_autoscaler_node_constraints" "this" {
+  cluster_id = castai_eks_cluster.this.id
+}
+
+check "team_node_template_within_guardrails" {
+  assert {
+    condition = (
+      castai_node_template.team.constraints[0].min_cpu >= data.castai_autoscaler_node_constraints.this.min_cpu &&
+      castai_node_template.team.constraints[0].max_cpu <= data.castai_autoscaler_node_constraints.this.max_cpu &&
+      castai_node_template.team.constraints[0].min_memory >= data.castai_autoscaler_node_constraints.this.min_memory &&
+      castai_node_template.team.constraints[0].max_memory <= data.castai_autoscaler_node_constraints.this.max_memory
+    )
+    error_message = "Team node template constraints must stay within cluster-wide node constraints."
+  }
+}
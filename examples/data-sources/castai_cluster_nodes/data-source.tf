@@ -0,0 +1,13 @@
+# List spot nodes created from the "spot-tmpl" node template and owned by the data team.
+data "castai_cluster_nodes" "data_team_spot" {
+  cluster_id     = castai_eks_cluster.this.id
+  node_template  = "spot-tmpl"
+  lifecycle_type = "spot"
+  labels = {
+    team = "data"
+  }
+}
+
+output "data_team_spot_node_names" {
+  value = [for node in data.castai_cluster_nodes.data_team_spot.nodes : node.name]
+}
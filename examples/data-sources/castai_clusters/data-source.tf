@@ -0,0 +1,23 @@
+# Find all healthy production EKS clusters.
+data "castai_clusters" "prod" {
+  provider_type = "eks"
+  status        = "ready"
+  tags = {
+    env = "prod"
+  }
+}
+
+# Enable the unschedulable pods policy on every matching cluster.
+resource "castai_autoscaler" "prod" {
+  for_each = { for cluster in data.castai_clusters.prod.clusters : cluster.name => cluster.id }
+
+  cluster_id = each.value
+
+  autoscaler_settings {
+    enabled = true
+
+    unschedulable_pods {
+      enabled = true
+    }
+  }
+}
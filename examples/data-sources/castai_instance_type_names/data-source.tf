@@ -0,0 +1,8 @@
+data "castai_instance_type_names" "aws_m5" {
+  cloud_provider = "aws"
+  family         = "m5"
+}
+
+output "m5_instance_types" {
+  value = data.castai_instance_type_names.aws_m5.names
+}
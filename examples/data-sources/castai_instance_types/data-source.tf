@@ -0,0 +1,31 @@
+data "castai_instance_types" "spot" {
+  cluster_id = castai_eks_cluster.this.id
+
+  constraints {
+    spot          = true
+    on_demand     = false
+    min_cpu       = 4
+    max_cpu       = 16
+    architectures = ["amd64"]
+  }
+}
+
+resource "castai_node_template" "spot" {
+  cluster_id = castai_eks_cluster.this.id
+  name       = "spot"
+
+  constraints {
+    spot          = true
+    on_demand     = false
+    min_cpu       = 4
+    max_cpu       = 16
+    architectures = ["amd64"]
+  }
+
+  lifecycle {
+    precondition {
+      condition     = length(data.castai_instance_types.spot.instance_types) >= 5
+      error_message = "At least 5 spot capable instance types have to match the node template constraints."
+    }
+  }
+}
@@ -0,0 +1,15 @@
+data "castai_node_configuration_suggestion" "this" {
+  cluster_id = castai_eks_cluster.this.id
+}
+
+resource "castai_node_configuration" "default" {
+  cluster_id = castai_eks_cluster.this.id
+  name       = "default"
+  subnets    = data.castai_node_configuration_suggestion.this.subnets
+
+  eks {
+    instance_profile_arn      = data.castai_node_configuration_suggestion.this.eks[0].instance_profile_arn
+    security_groups           = data.castai_node_configuration_suggestion.this.eks[0].security_groups
+    max_pods_per_node_formula = data.castai_node_configuration_suggestion.this.max_pods_per_node_formula_presets[0]
+  }
+}
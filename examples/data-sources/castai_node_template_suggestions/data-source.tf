@@ -0,0 +1,37 @@
+data "castai_node_template_suggestions" "this" {
+  cluster_id = castai_eks_cluster.this.id
+}
+
+resource "castai_node_template" "suggested" {
+  for_each = { for t in data.castai_node_template_suggestions.this.node_templates : t.name => t }
+
+  cluster_id                                    = castai_eks_cluster.this.id
+  name                                          = each.value.name
+  configuration_id                              = each.value.configuration_id != "" ? each.value.configuration_id : null
+  should_taint                                  = each.value.should_taint
+  custom_labels                                 = each.value.custom_labels
+  custom_instances_enabled                      = each.value.custom_instances_enabled
+  custom_instances_with_extended_memory_enabled = each.value.custom_instances_with_extended_memory_enabled
+
+  dynamic "custom_taints" {
+    for_each = each.value.custom_taints
+    content {
+      key    = custom_taints.value.key
+      value  = custom_taints.value.value
+      effect = custom_taints.value.effect
+    }
+  }
+
+  dynamic "constraints" {
+    for_each = each.value.constraints
+    content {
+      spot          = constraints.value.spot
+      on_demand     = constraints.value.on_demand
+      min_cpu       = constraints.value.min_cpu
+      max_cpu       = constraints.value.max_cpu
+      min_memory    = constraints.value.min_memory
+      max_memory    = constraints.value.max_memory
+      architectures = constraints.value.architectures
+    }
+  }
+}
@@ -0,0 +1,30 @@
+data "castai_rebalancing_schedule_preview" "spots" {
+  cluster_id = castai_eks_cluster.this.id
+
+  schedule {
+    cron = "*/30 * * * *"
+  }
+  trigger_conditions {
+    savings_percentage = 20
+  }
+  launch_configuration {
+    num_targeted_nodes = 3
+    selector = jsonencode({
+      nodeSelectorTerms = [{
+        matchExpressions = [
+          {
+            key      = "scheduling.cast.ai/spot"
+            operator = "Exists"
+          }
+        ]
+      }]
+    })
+  }
+}
+
+check "rebalancing_schedule_targets_nodes" {
+  assert {
+    condition     = length(data.castai_rebalancing_schedule_preview.spots.affected_node_ids) > 0
+    error_message = "Rebalancing schedule would not target any nodes."
+  }
+}
@@ -0,0 +1,7 @@
+data "castai_regions" "aws" {
+  cloud_provider = "aws"
+}
+
+output "aws_regions" {
+  value = data.castai_regions.aws.names
+}
@@ -0,0 +1,14 @@
+data "castai_workloads" "team_a" {
+  cluster_id = castai_eks_cluster.this.id
+  namespaces = ["team-a"]
+  kinds      = ["Deployment", "StatefulSet"]
+}
+
+check "team_a_workloads_use_team_policy" {
+  assert {
+    condition = alltrue([
+      for w in data.castai_workloads.team_a.workloads : w.scaling_policy_name == castai_workload_scaling_policy.team_a.name
+    ])
+    error_message = "All team-a workloads must be assigned to the team-a scaling policy."
+  }
+}
@@ -0,0 +1,15 @@
+data "castai_zones" "us_east_1" {
+  cloud_provider = "aws"
+  region         = "us-east-1"
+}
+
+variable "node_template_azs" {
+  type = list(string)
+}
+
+check "node_template_azs_exist" {
+  assert {
+    condition     = alltrue([for az in var.node_template_azs : contains(data.castai_zones.us_east_1.names, az)])
+    error_message = "All node template availability zones must exist in us-east-1."
+  }
+}
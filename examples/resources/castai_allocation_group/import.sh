@@ -1,3 +1,5 @@
-# Importing via direct allocation group ID is also possible.
-# Will use the default organization ID, that is associated with the API token.
+# Associate terraform resource "my_allocation_group" with an allocation group named "team-a".
+terraform import 'castai_allocation_group.my_allocation_group' team-a
+
+# Importing via direct allocation group ID is also possible, and required when several allocation groups share the name.
 terraform import 'castai_allocation_group.my_allocation_group' e5ee784d-2c4b-4820-ab4e-16e4b81534a4
@@ -0,0 +1,9 @@
+resource "castai_autoscaler_cluster_limits" "platform" {
+  cluster_id = castai_eks_cluster.test.id
+
+  enabled = true
+
+  cpu {
+    max_cores = 200
+  }
+}
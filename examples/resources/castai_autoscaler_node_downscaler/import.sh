@@ -0,0 +1,2 @@
+# Import node downscaler policy of the cluster using the CAST AI cluster ID.
+terraform import castai_autoscaler_node_downscaler.this b6bfc074-a267-400f-b8f1-db0850c36aa4
@@ -0,0 +1,16 @@
+resource "castai_autoscaler_node_downscaler" "app_team" {
+  cluster_id = castai_eks_cluster.test.id
+
+  enabled = true
+
+  empty_nodes {
+    enabled       = true
+    delay_seconds = 120
+  }
+
+  evictor {
+    enabled         = true
+    aggressive_mode = false
+    cycle_interval  = "5m"
+  }
+}
@@ -0,0 +1,10 @@
+resource "castai_autoscaler_spot_instances" "platform" {
+  cluster_id = castai_eks_cluster.test.id
+
+  enabled = true
+
+  spot_backups {
+    enabled                          = true
+    spot_backup_restore_rate_seconds = 1800
+  }
+}
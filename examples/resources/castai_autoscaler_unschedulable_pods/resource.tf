@@ -0,0 +1,9 @@
+resource "castai_autoscaler_unschedulable_pods" "platform" {
+  cluster_id = castai_eks_cluster.test.id
+
+  enabled = true
+
+  pod_pinner {
+    enabled = true
+  }
+}
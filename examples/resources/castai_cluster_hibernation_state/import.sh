@@ -0,0 +1,2 @@
+# Import hibernation state of the cluster using the CAST AI cluster ID.
+terraform import castai_cluster_hibernation_state.sandbox b6bfc074-a267-400f-b8f1-db0850c36aa4
@@ -0,0 +1,18 @@
+resource "castai_cluster_hibernation_state" "sandbox" {
+  cluster_id    = castai_gke_cluster.sandbox.id
+  desired_state = "hibernated"
+
+  resume_node_config {
+    instance_type = "e2-standard-4"
+    zone          = "europe-west1-b"
+
+    spot_config {
+      spot = true
+    }
+  }
+
+  timeouts {
+    create = "30m"
+    update = "30m"
+  }
+}
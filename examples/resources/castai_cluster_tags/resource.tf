@@ -0,0 +1,17 @@
+# Manage the full tag map of the cluster. Tags not listed here are removed.
+resource "castai_cluster_tags" "this" {
+  cluster_id = castai_eks_cluster.this.id
+  tags = {
+    environment = "production"
+    team        = "platform"
+  }
+}
+
+# Manage only the listed keys, leaving tags set elsewhere untouched.
+resource "castai_cluster_tags" "cost_center" {
+  cluster_id = castai_gke_cluster.this.id
+  exclusive  = false
+  tags = {
+    cost-center = "1234"
+  }
+}
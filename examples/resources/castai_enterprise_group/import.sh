@@ -0,0 +1,6 @@
+# Import using enterprise ID and group name/ID in format <enterprise id>/<group id|group name>.
+terraform import 'castai_enterprise_group.my_group' 63895dfe-dc8b-49b6-959c-3f3545de525a/platform-team
+
+# Importing without enterprise ID is also possible.
+# Will use the default organization ID, that is associated with the API token, as the enterprise ID.
+terraform import 'castai_enterprise_group.my_group' e5ee784d-2c4b-4820-ab4e-16e4b81534a4
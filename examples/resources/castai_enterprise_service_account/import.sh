@@ -0,0 +1,6 @@
+# Import using enterprise ID and service account name/ID in format <enterprise id>/<service account id|service account name>.
+terraform import 'castai_enterprise_service_account.my_service_account' 63895dfe-dc8b-49b6-959c-3f3545de525a/ci
+
+# Importing without enterprise ID is also possible.
+# Will use the default organization ID, that is associated with the API token, as the enterprise ID.
+terraform import 'castai_enterprise_service_account.my_service_account' e5ee784d-2c4b-4820-ab4e-16e4b81534a4
@@ -0,0 +1,2 @@
+# Import evictor advanced config of the cluster using the CAST AI cluster ID.
+terraform import castai_evictor_advanced_config.config b6bfc074-a267-400f-b8f1-db0850c36aa4
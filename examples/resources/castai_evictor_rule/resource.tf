@@ -0,0 +1,24 @@
+resource "castai_evictor_rule" "batch_jobs" {
+  cluster_id = castai_eks_cluster.test.id
+
+  pod_selector {
+    kind      = "Job"
+    namespace = "batch"
+    match_labels = {
+      "team" = "data"
+    }
+  }
+  removal_disabled = true
+}
+
+resource "castai_evictor_rule" "spot_nodes" {
+  cluster_id = castai_eks_cluster.test.id
+
+  node_selector {
+    match_expressions {
+      key      = "scheduling.cast.ai/spot"
+      operator = "Exists"
+    }
+  }
+  disposable = true
+}
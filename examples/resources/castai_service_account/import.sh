@@ -0,0 +1,6 @@
+# Associate terraform resource "service_account" with a service account named "ci".
+# Will use the default organization ID, that is associated with the API token.
+terraform import 'castai_service_account.service_account' ci
+
+# Import using organization ID and service account name/ID in format <organization id>/<service account id|service account name>.
+terraform import 'castai_service_account.service_account' 63895dfe-dc8b-49b6-959c-3f3545de525a/e5ee784d-2c4b-4820-ab4e-16e4b81534a4
@@ -0,0 +1,7 @@
+# Import using service account name/ID and key name/ID in format <service account id|service account name>/<key id|key name>.
+# Will use the default organization ID, that is associated with the API token.
+terraform import 'castai_service_account_key.key' ci/ci-key
+
+# Import using organization ID in format <organization id>/<service account id|service account name>/<key id|key name>.
+# The key token is only returned when the key is created and stays empty after import.
+terraform import 'castai_service_account_key.key' 63895dfe-dc8b-49b6-959c-3f3545de525a/e5ee784d-2c4b-4820-ab4e-16e4b81534a4/da5664b3-87bf-4e03-9d1c-ec26049991b7
@@ -0,0 +1,2 @@
+# Import the override of a workload by cluster ID, namespace, kind and name.
+terraform import castai_workload_override.payments_api 105e6fa3-20b1-424e-b589-9a64d1eeabea/payments/Deployment/api
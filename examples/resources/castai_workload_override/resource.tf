@@ -0,0 +1,22 @@
+resource "castai_workload_override" "payments_api" {
+  cluster_id        = castai_gke_cluster.cluster.id
+  namespace         = "payments"
+  kind              = "Deployment"
+  name              = "api"
+  scaling_policy_id = castai_workload_scaling_policy.services.id
+
+  vertical {
+    management_option = "MANAGED"
+    cpu {
+      min = 0.5
+      max = 4
+    }
+    memory {
+      max = 8192
+    }
+  }
+
+  horizontal {
+    management_option = "READ_ONLY"
+  }
+}
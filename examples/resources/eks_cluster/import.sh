@@ -0,0 +1,2 @@
+# Import EKS cluster by CAST AI cluster ID. Credentials are write-only and are applied from configuration on the next apply.
+terraform import castai_eks_cluster.this 105e6fa3-20b1-424e-a589-9a64d1eeabea
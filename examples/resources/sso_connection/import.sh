@@ -0,0 +1,6 @@
+# Associate terraform resource "sso" with an SSO connection named "okta-sso".
+terraform import 'castai_sso_connection.sso' okta-sso
+
+# Importing via direct connection ID is also possible.
+# Client secrets are imported as the hashes returned by the API, so the configured secret does not produce a diff.
+terraform import 'castai_sso_connection.sso' e5ee784d-2c4b-4820-ab4e-16e4b81534a4
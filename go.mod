@@ -4,7 +4,6 @@ go 1.25.5
 
 require (
 	github.com/cenkalti/backoff/v4 v4.1.3
-	github.com/evanphx/json-patch v4.12.0+incompatible
 	github.com/golang/mock v1.5.0
 	github.com/google/uuid v1.6.0
 	github.com/gruntwork-io/terratest v0.40.18
@@ -76,7 +75,6 @@ require (
 	github.com/mitchellh/go-wordwrap v1.0.1 // indirect
 	github.com/mitchellh/reflectwalk v1.0.2 // indirect
 	github.com/oklog/run v1.1.0 // indirect
-	github.com/pkg/errors v0.9.1 // indirect
 	github.com/pmezard/go-difflib v1.0.1-0.20181226105442-5d4384ee4fb2 // indirect
 	github.com/tmccombs/hcl2json v0.3.3 // indirect
 	github.com/ulikunitz/xz v0.5.8 // indirect